@@ -0,0 +1,8 @@
+# Look up an application list that was not created by Terraform
+data "sse_application_list" "approved" {
+  name = "Approved Applications"
+}
+
+output "approved_application_ids" {
+  value = data.sse_application_list.approved.application_ids
+}
@@ -0,0 +1,23 @@
+# Applications seen in web traffic that were not reviewed yet and are risky
+data "sse_application_usage" "shadow_it" {
+  sources        = ["dns", "swg"]
+  labels         = ["unreviewed", "notApproved"]
+  weighted_risks = ["high", "veryHigh"]
+}
+
+output "shadow_it_applications" {
+  value = data.sse_application_usage.shadow_it.names
+}
+
+# Usage of a single identity on one day
+data "sse_application_usage" "engineering" {
+  identity_id = 123456
+  date        = "2026-01-15"
+}
+
+output "engineering_top_applications" {
+  value = {
+    for app in data.sse_application_usage.engineering.applications :
+    app.name => app.last_detected
+  }
+}
@@ -0,0 +1,6 @@
+# Fetch all internal domains
+data "sse_internal_domains" "all" {}
+
+output "internal_domains" {
+  value = [for d in data.sse_internal_domains.all.internal_domains : d.domain]
+}
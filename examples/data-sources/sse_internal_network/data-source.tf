@@ -0,0 +1,8 @@
+# Look up an internal network by name
+data "sse_internal_network" "branch_office" {
+  name = "Branch Office LAN"
+}
+
+output "branch_office_cidr" {
+  value = "${data.sse_internal_network.branch_office.ip_address}/${data.sse_internal_network.branch_office.prefix_length}"
+}
@@ -0,0 +1,6 @@
+# Fetch all internal networks
+data "sse_internal_networks" "all" {}
+
+output "internal_networks" {
+  value = data.sse_internal_networks.all.internal_networks
+}
@@ -0,0 +1,32 @@
+# Signatures for a CVE, with their action in the profile
+data "sse_ips_signatures" "log4shell" {
+  profile_id = sse_ips_profile.tuned.id
+  cve        = "CVE-2021-44228"
+}
+
+# Signatures of a rule category that only warn
+data "sse_ips_signatures" "backdoor_warn" {
+  profile_id = sse_ips_profile.tuned.id
+  category   = "MALWARE-BACKDOOR"
+  action     = "warn"
+}
+
+# Signatures of a Snort class within a rule category
+data "sse_ips_signatures" "trojans" {
+  profile_id = sse_ips_profile.tuned.id
+  category   = "MALWARE-CNC"
+  class      = "trojan-activity"
+}
+
+# Signatures the profile overrides
+data "sse_ips_signatures" "overrides" {
+  profile_id     = sse_ips_profile.tuned.id
+  overrides_only = true
+}
+
+output "log4shell_signatures" {
+  value = {
+    for sig in data.sse_ips_signatures.log4shell.signatures :
+    "${sig.gid}:${sig.sid}" => sig.action
+  }
+}
@@ -0,0 +1,8 @@
+# Look up a network by name
+data "sse_network" "headquarters" {
+  name = "Headquarters Egress"
+}
+
+output "headquarters_identity_id" {
+  value = data.sse_network.headquarters.id
+}
@@ -0,0 +1,15 @@
+# Look up a network object or group that was not created by Terraform
+data "sse_network_object" "corporate" {
+  name = "Corporate Networks"
+}
+
+# Choose the group when an object and a group share a name
+data "sse_network_object" "servers" {
+  name = "servers"
+  kind = "group"
+}
+
+resource "sse_network_object_group" "internal" {
+  name      = "internal"
+  group_ids = [data.sse_network_object.servers.id]
+}
@@ -0,0 +1,6 @@
+# Fetch all network object groups
+data "sse_network_object_groups" "all" {}
+
+output "network_object_groups" {
+  value = data.sse_network_object_groups.all.network_object_groups
+}
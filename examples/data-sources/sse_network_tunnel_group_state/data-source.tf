@@ -0,0 +1,23 @@
+# Verify the New York branch tunnels are up after a rollout
+check "new_york_tunnels_up" {
+  data "sse_network_tunnel_group_state" "new_york" {
+    id = sse_network_tunnel_group.new_york.id
+  }
+
+  assert {
+    condition     = alltrue([for hub in data.sse_network_tunnel_group_state.new_york.network_tunnel_groups[0].hubs : hub.status == "UP"])
+    error_message = "Not all hubs of the New York tunnel group are UP."
+  }
+
+  assert {
+    condition     = alltrue([for peer in data.sse_network_tunnel_group_state.new_york.peers : peer.ike_state == "ESTABLISHED"])
+    error_message = "Not all New York tunnels have an established IKE security association."
+  }
+}
+
+# State of every tunnel group
+data "sse_network_tunnel_group_state" "all" {}
+
+output "disconnected_tunnel_groups" {
+  value = [for group in data.sse_network_tunnel_group_state.all.network_tunnel_groups : group.name if group.status == "disconnected"]
+}
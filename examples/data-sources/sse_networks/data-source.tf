@@ -0,0 +1,6 @@
+# Fetch all networks
+data "sse_networks" "all" {}
+
+output "networks" {
+  value = data.sse_networks.all.networks
+}
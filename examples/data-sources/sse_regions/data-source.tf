@@ -0,0 +1,21 @@
+variable "branch_tunnel_passphrase" {
+  type      = string
+  sensitive = true
+}
+
+# Regions sorted by distance to the branch office egress IP
+data "sse_regions" "nearest" {
+  peer_ip = "198.51.100.8"
+}
+
+# Place the tunnel group in the nearest region
+resource "sse_network_tunnel_group" "branch_office" {
+  name           = "Branch Office Tunnels"
+  region         = data.sse_regions.nearest.regions[0].name
+  auth_id_prefix = "branchoffice"
+  passphrase     = var.branch_tunnel_passphrase
+}
+
+output "region_names" {
+  value = data.sse_regions.nearest.regions[*].name
+}
@@ -0,0 +1,8 @@
+# Look up a service object or group that was not created by Terraform
+data "sse_service_object" "https" {
+  name = "HTTPS"
+}
+
+output "https_ports" {
+  value = data.sse_service_object.https.values[0].ports
+}
@@ -0,0 +1,6 @@
+# Fetch all service object groups
+data "sse_service_object_groups" "all" {}
+
+output "service_object_groups" {
+  value = data.sse_service_object_groups.all.service_object_groups
+}
@@ -0,0 +1,8 @@
+# Look up a site by name
+data "sse_site" "headquarters" {
+  name = "Headquarters"
+}
+
+output "headquarters_site_id" {
+  value = data.sse_site.headquarters.id
+}
@@ -0,0 +1,6 @@
+# Fetch all sites
+data "sse_sites" "all" {}
+
+output "sites" {
+  value = data.sse_sites.all.sites
+}
@@ -7,9 +7,9 @@ terraform {
 }
 
 provider "sse" {
-  # client_id     = "..." # Set via SSE_CLIENT_ID env var
-  # client_secret = "..." # Set via SSE_CLIENT_SECRET env var
-  # region        = "..." # Set via SSE_REGION env var (optional, defaults to "us")
+  # client_id     = "..." # Or set via SSE_CLIENT_KEY / SSE_CLIENT_ID env var
+  # client_secret = "..." # Or set via SSE_CLIENT_SECRET env var
+  # region        = "..." # Or set via SSE_REGION env var (optional, defaults to "us")
 }
 
 resource "sse_network_object" "example" {
@@ -1,7 +1,26 @@
 provider "sse" {
-  # Configuration is loaded from environment variables:
-  # SSE_CLIENT_ID
-  # SSE_CLIENT_SECRET
-  # SSE_TOKEN_URL
-  # SSE_REGION (optional, defaults to "us", use "eu" for Europe)
+  # Connection attributes are optional and fall back to environment variables:
+  # client_id     -> SSE_CLIENT_KEY (or SSE_CLIENT_ID)
+  # client_secret -> SSE_CLIENT_SECRET
+  # region        -> SSE_REGION (defaults to "us", use "eu" for Europe)
+  # token_url     -> SSE_TOKEN_URL
+  # api_base_url  -> SSE_API_BASE_URL
+  # scopes        -> SSE_SCOPES
+}
+
+# Several organizations can be managed from one configuration with aliases.
+provider "sse" {
+  alias         = "emea"
+  client_id     = var.emea_client_id
+  client_secret = var.emea_client_secret
+  region        = "eu"
+}
+
+# Point the provider at a local mock server, routing the Reporting API elsewhere.
+provider "sse" {
+  alias        = "mock"
+  api_base_url = "http://127.0.0.1:8080/{scope}/v2"
+  api_endpoints = {
+    reports = "http://127.0.0.1:8081/reports.{region}/v2"
+  }
 }
@@ -0,0 +1,12 @@
+data "sse_application" "dropbox" {
+  name = "Dropbox"
+}
+
+# Applications can be given by ID, by name or both. Names are resolved to IDs
+# with the applications of the Reporting API. Reference the list in access rules with the
+# umbrella.destination.application_list_ids condition.
+resource "sse_application_list" "file_sharing" {
+  name              = "file-sharing"
+  application_ids   = [data.sse_application.dropbox.id]
+  application_names = ["Box", "WeTransfer"]
+}
@@ -0,0 +1,6 @@
+# Resolve corp.example.com through the internal DNS servers
+resource "sse_internal_domain" "corp" {
+  domain                     = "corp.example.com"
+  description                = "Corporate AD domain"
+  include_all_mobile_devices = true
+}
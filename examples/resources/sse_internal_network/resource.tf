@@ -0,0 +1,9 @@
+data "sse_network_tunnel_groups" "all" {}
+
+# Branch office subnet behind a network tunnel group
+resource "sse_internal_network" "branch_office" {
+  name          = "Branch Office LAN"
+  ip_address    = "10.20.0.0"
+  prefix_length = 24
+  tunnel_id     = data.sse_network_tunnel_groups.all.network_tunnel_groups[0].id
+}
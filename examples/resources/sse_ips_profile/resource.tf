@@ -0,0 +1,20 @@
+# Start from the balanced signature policy and tune individual signatures.
+# Signatures are identified by their GID and SID, see sse_ips_signatures.
+resource "sse_ips_profile" "tuned" {
+  name        = "balanced-tuned"
+  apply_to    = "balanced-ips"
+  system_mode = "prevention"
+
+  signature_overrides = [
+    {
+      gid    = 1
+      sid    = 58722
+      action = "block"
+    },
+    {
+      gid    = 1
+      sid    = 2000
+      action = "ignore"
+    },
+  ]
+}
@@ -0,0 +1,24 @@
+# Register the branch office egress IP as a network identity
+resource "sse_network" "branch_office" {
+  name          = "Branch Office Egress"
+  ip_address    = "198.51.100.8"
+  prefix_length = 32
+}
+
+# Use the network as a source identity in an access rule
+resource "sse_access_rule" "branch_office_internet" {
+  name       = "Branch Office Internet"
+  action     = "allow"
+  is_enabled = true
+
+  rule_conditions {
+    attribute_name     = "umbrella.source.identity_ids"
+    attribute_operator = "INTERSECT"
+    attribute_value    = jsonencode([sse_network.branch_office.id])
+  }
+
+  rule_settings {
+    setting_name  = "umbrella.default.traffic"
+    setting_value = "PUBLIC_INTERNET"
+  }
+}
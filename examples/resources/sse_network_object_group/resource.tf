@@ -0,0 +1,26 @@
+resource "sse_network_object" "web" {
+  name      = "web-servers"
+  type      = "network"
+  addresses = ["10.10.0.0/24"]
+}
+
+resource "sse_network_object" "db" {
+  name      = "db-servers"
+  type      = "network"
+  addresses = ["10.20.0.0/24"]
+}
+
+resource "sse_network_object_group" "backend" {
+  name       = "backend"
+  object_ids = [sse_network_object.db.object_id]
+}
+
+# A group of network objects and a nested group. Reference it in access rules
+# with the umbrella.destination.networkObjectGroupIds condition.
+resource "sse_network_object_group" "datacenter" {
+  name        = "datacenter"
+  description = "All data center servers"
+  object_ids  = [sse_network_object.web.object_id]
+  group_ids   = [sse_network_object_group.backend.id]
+}
+
@@ -0,0 +1,24 @@
+# Manage a large list of hosts, e.g. decoded from a CSV file with csvdecode()
+locals {
+  branch_servers = [
+    { name = "branch-1-server", address = "10.1.0.10" },
+    { name = "branch-2-server", address = "10.2.0.10" },
+    { name = "branch-3-server", address = "10.3.0.10" },
+  ]
+}
+
+resource "sse_network_objects_bulk" "branch_servers" {
+  objects = {
+    for server in local.branch_servers : server.name => {
+      type        = "host"
+      addresses   = [server.address]
+      description = "Branch server"
+    }
+  }
+}
+
+# Group all of them
+resource "sse_network_object_group" "branch_servers" {
+  name       = "branch-servers"
+  object_ids = values(sse_network_objects_bulk.branch_servers.object_ids)
+}
@@ -0,0 +1,27 @@
+variable "branch_tunnel_passphrase" {
+  type      = string
+  sensitive = true
+}
+
+# Tunnel group for the New York branch firewall
+resource "sse_network_tunnel_group" "new_york" {
+  name           = "New York Branch Tunnels"
+  region         = "us-east-1"
+  device_type    = "FTD"
+  auth_id_prefix = "newyorkbranch"
+  passphrase     = var.branch_tunnel_passphrase
+
+  routing = {
+    type          = "static"
+    network_cidrs = ["10.20.0.0/16"]
+  }
+}
+
+# Peer addresses and IKE IDs to configure on the firewall
+output "new_york_primary_hub" {
+  value = one([for hub in sse_network_tunnel_group.new_york.hubs : hub if hub.is_primary])
+}
+
+output "new_york_secondary_hub" {
+  value = one([for hub in sse_network_tunnel_group.new_york.hubs : hub if !hub.is_primary])
+}
@@ -0,0 +1,24 @@
+resource "sse_service_object" "https" {
+  name     = "https"
+  protocol = "tcp"
+  ports    = ["443"]
+}
+
+resource "sse_service_object" "rdp" {
+  name     = "rdp"
+  protocol = "tcp"
+  ports    = ["3389"]
+}
+
+resource "sse_service_object_group" "web" {
+  name       = "web"
+  object_ids = [sse_service_object.https.object_id]
+}
+
+# A group of service objects and a nested group
+resource "sse_service_object_group" "admin" {
+  name        = "admin"
+  description = "Services used by administrators"
+  object_ids  = [sse_service_object.rdp.object_id]
+  group_ids   = [sse_service_object_group.web.id]
+}
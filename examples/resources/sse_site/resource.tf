@@ -0,0 +1,4 @@
+# Site for the branch office internal networks
+resource "sse_site" "branch_office" {
+  name = "Branch Office"
+}
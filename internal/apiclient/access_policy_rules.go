@@ -4,9 +4,10 @@
 package apiclient
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
-	"io"
+	"iter"
 	"net/http"
 )
 
@@ -70,68 +71,43 @@ type RuleRequestUpdate struct {
 	RuleSettings    []RuleSetting   `json:"ruleSettings"`
 }
 
-// RulesResponse represents the list of rules response
-type RulesResponse struct {
-	Count   int    `json:"count"`
-	Result  []Rule `json:"result"`
-	Data    []Rule `json:"data"`
-	Items   []Rule `json:"items"`
-	Results []Rule `json:"results"`
-}
-
 // GetAccessRules lists all access rules
-func GetAccessRules(client *APIClient) ([]Rule, error) {
-	resp, err := client.Query(ScopePolicies, AccessRulesEndpoint, OperationGet, nil)
-	if err != nil {
-		return nil, fmt.Errorf("failed to get access rules: %w", err)
-	}
-	defer resp.Body.Close()
-
-	if resp.StatusCode != http.StatusOK {
-		body, _ := io.ReadAll(resp.Body)
-		return nil, fmt.Errorf("failed to get access rules. Status: %d, Response: %s", resp.StatusCode, string(body))
-	}
-
-	bodyBytes, err := io.ReadAll(resp.Body)
+func GetAccessRules(ctx context.Context, client *APIClient) ([]Rule, error) {
+	rules, err := CollectAll(accessRules(ctx, client))
 	if err != nil {
-		return nil, fmt.Errorf("failed to read response body: %w", err)
-	}
-
-	var data RulesResponse
-	if err := json.Unmarshal(bodyBytes, &data); err != nil {
-		return nil, fmt.Errorf("failed to decode response: %w", err)
+		return nil, err
 	}
 
-	// Handle inconsistent API response fields
-	if len(data.Result) == 0 {
-		if len(data.Data) > 0 {
-			data.Result = data.Data
-		} else if len(data.Items) > 0 {
-			data.Result = data.Items
-		} else if len(data.Results) > 0 {
-			data.Result = data.Results
-		}
-	}
-
-	if data.Count > 0 && len(data.Result) == 0 {
-		fmt.Printf("[DEBUG] Response body: %s\n", string(bodyBytes))
-	}
+	logDebug(ctx, ScopePolicies, "Retrieved access rules", map[string]interface{}{"count": len(rules)})
+	return rules, nil
+}
 
-	fmt.Printf("Success. GET %s, retrieved %d rules\n", AccessRulesEndpoint, data.Count)
-	return data.Result, nil
+// accessRules iterates over the rules of the Access policy.
+func accessRules(ctx context.Context, client *APIClient) iter.Seq2[Rule, error] {
+	return Paginate[Rule](ctx, client, ListRequest{
+		Scope:    ScopePolicies,
+		Endpoint: AccessRulesEndpoint,
+		Style:    PageOffset,
+		Action:   "get access rules",
+	})
 }
 
 // CreateAccessRule creates a new access rule
-func CreateAccessRule(client *APIClient, rule RuleRequest) (*Rule, error) {
-	resp, err := client.Query(ScopePolicies, AccessRulesEndpoint, OperationPost, rule)
+func CreateAccessRule(ctx context.Context, client *APIClient, rule RuleRequest) (*Rule, error) {
+	release, err := client.lockRuleset(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create access rule: %w", err)
+	}
+	defer release()
+
+	resp, err := client.Query(ctx, ScopePolicies, AccessRulesEndpoint, OperationPost, rule)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create access rule: %w", err)
 	}
 	defer resp.Body.Close()
 
 	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusCreated {
-		body, _ := io.ReadAll(resp.Body)
-		return nil, fmt.Errorf("failed to create access rule. Status: %d, Response: %s", resp.StatusCode, string(body))
+		return nil, NewAPIError(resp, "create access rule")
 	}
 
 	var createdRule Rule
@@ -139,22 +115,21 @@ func CreateAccessRule(client *APIClient, rule RuleRequest) (*Rule, error) {
 		return nil, fmt.Errorf("failed to deciode response: %w", err)
 	}
 
-	fmt.Printf("Success. Created access rule '%s' with ID: %d\n", createdRule.RuleName, createdRule.RuleID)
+	logDebug(ctx, ScopePolicies, "Created access rule", map[string]interface{}{"rule_id": createdRule.RuleID, "rule_name": createdRule.RuleName})
 	return &createdRule, nil
 }
 
 // GetAccessRuleDetails gets details of a specific access rule
-func GetAccessRuleDetails(client *APIClient, ruleID int) (*Rule, error) {
+func GetAccessRuleDetails(ctx context.Context, client *APIClient, ruleID int) (*Rule, error) {
 	endpoint := fmt.Sprintf(AccessRuleDetailsEndpoint, ruleID)
-	resp, err := client.Query(ScopePolicies, endpoint, OperationGet, nil)
+	resp, err := client.Query(ctx, ScopePolicies, endpoint, OperationGet, nil)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get access rule details: %w", err)
 	}
 	defer resp.Body.Close()
 
 	if resp.StatusCode != http.StatusOK {
-		body, _ := io.ReadAll(resp.Body)
-		return nil, fmt.Errorf("failed to get access rule %d. Status: %d, Response: %s", ruleID, resp.StatusCode, string(body))
+		return nil, NewAPIError(resp, fmt.Sprintf("get access rule %d", ruleID))
 	}
 
 	var rule Rule
@@ -162,22 +137,27 @@ func GetAccessRuleDetails(client *APIClient, ruleID int) (*Rule, error) {
 		return nil, fmt.Errorf("failed to decode response: %w", err)
 	}
 
-	fmt.Printf("Success. Retrieved access rule %d\n", ruleID)
+	logDebug(ctx, ScopePolicies, "Retrieved access rule", map[string]interface{}{"rule_id": ruleID})
 	return &rule, nil
 }
 
 // UpdateAccessRule updates an existing access rule
-func UpdateAccessRule(client *APIClient, ruleID int, rule RuleRequestUpdate) (*Rule, error) {
+func UpdateAccessRule(ctx context.Context, client *APIClient, ruleID int, rule RuleRequestUpdate) (*Rule, error) {
 	endpoint := fmt.Sprintf(AccessRuleDetailsEndpoint, ruleID)
-	resp, err := client.Query(ScopePolicies, endpoint, OperationPut, rule)
+	release, err := client.lockRuleset(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update access rule: %w", err)
+	}
+	defer release()
+
+	resp, err := client.Query(ctx, ScopePolicies, endpoint, OperationPut, rule)
 	if err != nil {
 		return nil, fmt.Errorf("failed to update access rule: %w", err)
 	}
 	defer resp.Body.Close()
 
 	if resp.StatusCode != http.StatusOK {
-		body, _ := io.ReadAll(resp.Body)
-		return nil, fmt.Errorf("failed to update access rule %d. Status: %d, Response: %s", ruleID, resp.StatusCode, string(body))
+		return nil, NewAPIError(resp, fmt.Sprintf("update access rule %d", ruleID))
 	}
 
 	var updatedRule Rule
@@ -185,66 +165,41 @@ func UpdateAccessRule(client *APIClient, ruleID int, rule RuleRequestUpdate) (*R
 		return nil, fmt.Errorf("failed to decode response: %w", err)
 	}
 
-	fmt.Printf("Success. Updated access rule %d\n", ruleID)
+	logDebug(ctx, ScopePolicies, "Updated access rule", map[string]interface{}{"rule_id": ruleID})
 	return &updatedRule, nil
 }
 
 // DeleteAccessRule deletes an access rule
-func DeleteAccessRule(client *APIClient, ruleID int) error {
+func DeleteAccessRule(ctx context.Context, client *APIClient, ruleID int) error {
 	endpoint := fmt.Sprintf(AccessRuleDetailsEndpoint, ruleID)
-	resp, err := client.Query(ScopePolicies, endpoint, OperationDelete, nil)
+	release, err := client.lockRuleset(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to delete access rule: %w", err)
+	}
+	defer release()
+
+	resp, err := client.Query(ctx, ScopePolicies, endpoint, OperationDelete, nil)
 	if err != nil {
 		return fmt.Errorf("failed to delete access rule: %w", err)
 	}
 	defer resp.Body.Close()
 
 	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusNoContent {
-		body, _ := io.ReadAll(resp.Body)
-		return fmt.Errorf("failed to delete access rule %d. Status: %d, Response: %s", ruleID, resp.StatusCode, string(body))
+		return NewAPIError(resp, fmt.Sprintf("delete access rule %d", ruleID))
 	}
 
-	fmt.Printf("Successfully deleted access rule with ID: %d\n", ruleID)
+	logDebug(ctx, ScopePolicies, "Deleted access rule", map[string]interface{}{"rule_id": ruleID})
 	return nil
 }
 
-func GetAccessRuleIDByName(client *APIClient, name string) (int, error) {
-	resp, err := client.Query(ScopePolicies, AccessRulesEndpoint, OperationGet, nil)
-	if err != nil {
-		return 0, fmt.Errorf("failed to get access rules: %w", err)
-	}
-	defer resp.Body.Close()
-
-	if resp.StatusCode != http.StatusOK {
-		body, _ := io.ReadAll(resp.Body)
-		return 0, fmt.Errorf("failed to get access rules. Status: %d, Response: %s", resp.StatusCode, string(body))
-	}
-
-	bodyBytes, err := io.ReadAll(resp.Body)
+func GetAccessRuleIDByName(ctx context.Context, client *APIClient, name string) (int, error) {
+	rule, found, err := FindFirst(accessRules(ctx, client), func(r Rule) bool { return r.RuleName == name })
 	if err != nil {
-		return 0, fmt.Errorf("failed to read response body: %w", err)
+		return 0, err
 	}
-
-	var data RulesResponse
-	if err := json.Unmarshal(bodyBytes, &data); err != nil {
-		return 0, fmt.Errorf("failed to decode response: %w", err)
-	}
-
-	// Handle inconsistent API response fields
-	if len(data.Result) == 0 {
-		if len(data.Data) > 0 {
-			data.Result = data.Data
-		} else if len(data.Items) > 0 {
-			data.Result = data.Items
-		} else if len(data.Results) > 0 {
-			data.Result = data.Results
-		}
-	}
-
-	for _, rule := range data.Result {
-		if rule.RuleName == name {
-			return rule.RuleID, nil
-		}
+	if !found {
+		return 0, fmt.Errorf("access rule with name '%s' not found", name)
 	}
 
-	return 0, fmt.Errorf("access rule with name '%s' not found", name)
+	return rule.RuleID, nil
 }
@@ -0,0 +1,154 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package apiclient
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"iter"
+	"net/http"
+)
+
+// Application Lists API endpoints
+const (
+	ApplicationListsEndpoint       = "applicationLists"
+	ApplicationListDetailsEndpoint = "applicationLists/%d"
+)
+
+// ApplicationList represents a list of applications and application categories
+// that app-control rules can reference. The list endpoint only returns the
+// summary fields, the details endpoint returns the members but no ID.
+type ApplicationList struct {
+	ID                     int64   `json:"applicationListId"`
+	Name                   string  `json:"applicationListName"`
+	IsDefault              bool    `json:"isDefault"`
+	ApplicationIDs         []int64 `json:"applicationIds,omitempty"`
+	ApplicationCategoryIDs []int64 `json:"applicationCategoryIds,omitempty"`
+	CreatedAt              string  `json:"createdAt,omitempty"`
+	ModifiedAt             string  `json:"modifiedAt,omitempty"`
+}
+
+// ApplicationListPayload is the request body to create or update an
+// application list. The API requires applicationIds, even when it is empty.
+type ApplicationListPayload struct {
+	Name                   string  `json:"applicationListName"`
+	IsDefault              bool    `json:"isDefault"`
+	ApplicationIDs         []int64 `json:"applicationIds"`
+	ApplicationCategoryIDs []int64 `json:"applicationCategoryIds"`
+}
+
+// GetApplicationLists retrieves the summaries of all application lists
+func GetApplicationLists(ctx context.Context, client *APIClient) ([]ApplicationList, error) {
+	return CollectAll(applicationLists(ctx, client))
+}
+
+// applicationLists iterates over the application lists of the organization.
+func applicationLists(ctx context.Context, client *APIClient) iter.Seq2[ApplicationList, error] {
+	return Paginate[ApplicationList](ctx, client, ListRequest{
+		Scope:    ScopePolicies,
+		Endpoint: ApplicationListsEndpoint,
+		Style:    PageNone,
+		Action:   "get application lists",
+	})
+}
+
+// GetApplicationListIDByName returns the ID of the application list with the given name
+func GetApplicationListIDByName(ctx context.Context, client *APIClient, name string) (int64, error) {
+	list, found, err := FindFirst(applicationLists(ctx, client), func(l ApplicationList) bool { return l.Name == name })
+	if err != nil {
+		return 0, err
+	}
+	if !found {
+		return 0, fmt.Errorf("application list with name '%s' not found", name)
+	}
+
+	return list.ID, nil
+}
+
+// GetApplicationList retrieves an application list by ID
+func GetApplicationList(ctx context.Context, client *APIClient, id int64) (*ApplicationList, error) {
+	resp, err := client.Query(ctx, ScopePolicies, fmt.Sprintf(ApplicationListDetailsEndpoint, id), OperationGet, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get application list: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, NewAPIError(resp, fmt.Sprintf("get application list %d", id))
+	}
+
+	var list ApplicationList
+	if err := json.NewDecoder(resp.Body).Decode(&list); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+	list.ID = id
+
+	return &list, nil
+}
+
+// CreateApplicationList creates a new application list. The create response
+// does not include the ID of the list, so it is looked up by name.
+func CreateApplicationList(ctx context.Context, client *APIClient, payload ApplicationListPayload) (*ApplicationList, error) {
+	resp, err := client.Query(ctx, ScopePolicies, ApplicationListsEndpoint, OperationPost, payload)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create application list: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusCreated {
+		return nil, NewAPIError(resp, fmt.Sprintf("create application list '%s'", payload.Name))
+	}
+
+	var list ApplicationList
+	if err := json.NewDecoder(resp.Body).Decode(&list); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	if list.ID == 0 {
+		list.ID, err = GetApplicationListIDByName(ctx, client, payload.Name)
+		if err != nil {
+			return nil, fmt.Errorf("failed to find created application list: %w", err)
+		}
+	}
+
+	logDebug(ctx, ScopePolicies, "Created application list", map[string]interface{}{"application_list_id": list.ID, "application_list_name": list.Name})
+	return &list, nil
+}
+
+// UpdateApplicationList replaces the properties of an application list
+func UpdateApplicationList(ctx context.Context, client *APIClient, id int64, payload ApplicationListPayload) (*ApplicationList, error) {
+	resp, err := client.Query(ctx, ScopePolicies, fmt.Sprintf(ApplicationListDetailsEndpoint, id), OperationPut, payload)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update application list: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, NewAPIError(resp, fmt.Sprintf("update application list %d", id))
+	}
+
+	var list ApplicationList
+	if err := json.NewDecoder(resp.Body).Decode(&list); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+	list.ID = id
+
+	return &list, nil
+}
+
+// DeleteApplicationList deletes an application list
+func DeleteApplicationList(ctx context.Context, client *APIClient, id int64) error {
+	resp, err := client.Query(ctx, ScopePolicies, fmt.Sprintf(ApplicationListDetailsEndpoint, id), OperationDelete, nil)
+	if err != nil {
+		return fmt.Errorf("failed to delete application list: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusNoContent {
+		return NewAPIError(resp, fmt.Sprintf("delete application list %d", id))
+	}
+
+	return nil
+}
@@ -0,0 +1,93 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package apiclient
+
+import (
+	"context"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// AppDiscoveryApplicationsEndpoint lists the applications seen in the traffic
+// of the organization.
+const AppDiscoveryApplicationsEndpoint = "appDiscovery/applications"
+
+// ApplicationUsageSource is the traffic of a discovered application from one
+// log source. Only the fields of the source named by Name are meaningful:
+// requests for dns, bytes for swg and events for cdfw.
+type ApplicationUsageSource struct {
+	Name            string `json:"name"`
+	Requests        int64  `json:"requests"`
+	BlockedRequests int64  `json:"blockedRequests"`
+	TotalTraffic    int64  `json:"totalTraffic"`
+	BytesIn         int64  `json:"bytesIn"`
+	BytesOut        int64  `json:"bytesOut"`
+	BlockedBytesOut int64  `json:"blockedBytesOut"`
+	Events          int64  `json:"events"`
+	BlockedEvents   int64  `json:"blockedEvents"`
+}
+
+// ApplicationUsage is an application discovered in the traffic of the
+// organization.
+type ApplicationUsage struct {
+	ID            string                   `json:"id"`
+	Name          string                   `json:"name"`
+	Label         string                   `json:"label"`
+	WeightedRisk  string                   `json:"weightedRisk"`
+	Category      string                   `json:"category"`
+	AppType       string                   `json:"appType"`
+	Sources       []ApplicationUsageSource `json:"sources"`
+	FirstDetected string                   `json:"firstDetected"`
+	LastDetected  string                   `json:"lastDetected"`
+}
+
+// ApplicationUsageFilters are the optional filters of GetApplicationUsage.
+type ApplicationUsageFilters struct {
+	// IdentityID limits the usage to the traffic of one identity.
+	IdentityID int64
+	// Date limits the usage to one day (YYYY-MM-DD). By default the last 90
+	// days are reported.
+	Date          string
+	Sources       []string
+	Labels        []string
+	WeightedRisks []string
+	Categories    []string
+	AppTypes      []string
+}
+
+// GetApplicationUsage retrieves the applications seen in traffic, with their
+// request counts and detection times, from the App Discovery API.
+func GetApplicationUsage(ctx context.Context, client *APIClient, filters ApplicationUsageFilters) ([]ApplicationUsage, error) {
+	query := url.Values{}
+	if filters.IdentityID != 0 {
+		query.Set("identity", strconv.FormatInt(filters.IdentityID, 10))
+	}
+	if filters.Date != "" {
+		query.Set("date", filters.Date)
+	}
+	for key, values := range map[string][]string{
+		"sources":      filters.Sources,
+		"labels":       filters.Labels,
+		"weightedRisk": filters.WeightedRisks,
+		"categories":   filters.Categories,
+		"appTypes":     filters.AppTypes,
+	} {
+		if len(values) > 0 {
+			query.Set(key, strings.Join(values, ","))
+		}
+	}
+
+	endpoint := AppDiscoveryApplicationsEndpoint
+	if len(query) > 0 {
+		endpoint += "?" + query.Encode()
+	}
+
+	return CollectAll(Paginate[ApplicationUsage](ctx, client, ListRequest{
+		Scope:    ScopeReports,
+		Endpoint: endpoint,
+		Style:    PageOffset,
+		Action:   "get application usage",
+	}))
+}
@@ -5,13 +5,34 @@ package apiclient
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
 	"net/http"
 	"net/url"
 	"strings"
+	"sync"
 	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Defaults used when the provider configuration does not override them
+const (
+	DefaultTokenURL   = "https://api.sse.cisco.com/auth/v2/token"
+	DefaultAPIBaseURL = "https://api.sse.cisco.com"
+	DefaultRegion     = "us"
+)
+
+// ScopeAuth names the logging subsystem of OAuth token requests. It is not an
+// API scope.
+const ScopeAuth = "auth"
+
+// Placeholders understood by BaseURL and ScopeURLs
+const (
+	ScopePlaceholder  = "{scope}"
+	RegionPlaceholder = "{region}"
 )
 
 // Scopes
@@ -60,6 +81,34 @@ type APIClient struct {
 	Token        *Token
 	HTTPClient   *http.Client
 	Region       string
+	// BaseURL is either a plain base URL, to which "/{scope}/v2" is appended,
+	// or a template containing the {scope} and {region} placeholders.
+	BaseURL string
+	// ScopeURLs overrides the base URI of individual scopes. Values may use
+	// the same placeholders as BaseURL but are used as-is otherwise.
+	ScopeURLs map[string]string
+	// RetryPolicy decides how transport errors, 429 responses and locked
+	// ruleset conflicts are retried. Nil uses DefaultRetryPolicy.
+	RetryPolicy RetryPolicy
+	// RequestsPerSecond is the request budget of each API scope. Zero or
+	// less disables client-side rate limiting.
+	RequestsPerSecond float64
+	// RuleMutationConcurrency is the number of access rule changes that may
+	// be in flight at once. Values below one are treated as one.
+	RuleMutationConcurrency int
+	// WriteDelay is waited after every access rule change before the
+	// ruleset lock is handed to the next change.
+	WriteDelay time.Duration
+
+	// tokenMu guards Token, which is shared by the concurrent requests of
+	// all resources.
+	tokenMu sync.Mutex
+
+	limitersMu sync.Mutex
+	limiters   map[string]*RateLimiter
+
+	rulesetOnce sync.Once
+	rulesetSem  chan struct{}
 }
 
 // NewAPIClient creates a new API client instance
@@ -76,12 +125,26 @@ func NewAPIClient(tokenURL, clientID, clientSecret string, scopes []string, regi
 		HTTPClient: &http.Client{
 			Timeout: 30 * time.Second,
 		},
-		Region: region,
+		Region:                  region,
+		BaseURL:                 DefaultAPIBaseURL,
+		RetryPolicy:             DefaultRetryPolicy(),
+		RequestsPerSecond:       DefaultRequestsPerSecond,
+		RuleMutationConcurrency: DefaultRuleMutationConcurrency,
+		WriteDelay:              DefaultWriteDelay,
 	}
 }
 
 // GetToken fetches a new OAuth token
-func (c *APIClient) GetToken() error {
+func (c *APIClient) GetToken(ctx context.Context) error {
+	c.tokenMu.Lock()
+	defer c.tokenMu.Unlock()
+
+	return c.fetchToken(ctx)
+}
+
+// fetchToken fetches a new OAuth token and stores it in c.Token. The caller
+// must hold c.tokenMu.
+func (c *APIClient) fetchToken(ctx context.Context) error {
 	data := url.Values{}
 	data.Set("grant_type", "client_credentials")
 	data.Set("client_id", c.ClientID)
@@ -91,7 +154,7 @@ func (c *APIClient) GetToken() error {
 		data.Set("scope", strings.Join(c.Scopes, " "))
 	}
 
-	req, err := http.NewRequest("POST", c.TokenURL, strings.NewReader(data.Encode()))
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.TokenURL, strings.NewReader(data.Encode()))
 	if err != nil {
 		return fmt.Errorf("failed to create token request: %w", err)
 	}
@@ -99,15 +162,25 @@ func (c *APIClient) GetToken() error {
 	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
 	req.SetBasicAuth(c.ClientID, c.ClientSecret)
 
+	logDebug(ctx, ScopeAuth, "Requesting OAuth token", map[string]interface{}{
+		"http_url":  c.TokenURL,
+		"client_id": c.ClientID,
+		"scopes":    c.Scopes,
+	})
+
 	resp, err := c.HTTPClient.Do(req)
 	if err != nil {
 		return fmt.Errorf("failed to request token: %w", err)
 	}
 	defer resp.Body.Close()
 
+	logDebug(ctx, ScopeAuth, "Received OAuth token response", map[string]interface{}{
+		"http_status_code": resp.StatusCode,
+		"http_request_id":  requestID(resp),
+	})
+
 	if resp.StatusCode != http.StatusOK {
-		body, _ := io.ReadAll(resp.Body)
-		return fmt.Errorf("failed to obtain token, status: %d, response: %s", resp.StatusCode, string(body))
+		return NewAPIError(resp, "obtain token")
 	}
 
 	var token Token
@@ -120,20 +193,42 @@ func (c *APIClient) GetToken() error {
 	return nil
 }
 
-// ensureToken ensures we have a valid token
-func (c *APIClient) ensureToken() error {
+// ensureToken ensures we have a valid token and returns its access token.
+// Concurrent callers wait for a single refresh instead of each fetching a
+// token.
+func (c *APIClient) ensureToken(ctx context.Context) (string, error) {
+	c.tokenMu.Lock()
+	defer c.tokenMu.Unlock()
+
 	if c.Token == nil || c.Token.IsExpired() {
-		return c.GetToken()
+		if err := c.fetchToken(ctx); err != nil {
+			return "", err
+		}
 	}
-	return nil
+	return c.Token.AccessToken, nil
 }
 
-// Query executes an API request with automatic token refresh
-func (c *APIClient) Query(scope, endpoint, operation string, requestData interface{}) (*http.Response, error) {
-	baseURI := fmt.Sprintf("https://api.sse.cisco.com/%s/v2", scope)
-	if scope == ScopeReports && c.Region != "" {
-		baseURI = fmt.Sprintf("https://api.sse.cisco.com/%s.%s/v2", scope, c.Region)
+// invalidateToken drops the token if it is still the rejected one, so that
+// a token already refreshed by another request is not thrown away.
+func (c *APIClient) invalidateToken(rejected string) {
+	c.tokenMu.Lock()
+	defer c.tokenMu.Unlock()
+
+	if c.Token != nil && c.Token.AccessToken == rejected {
+		c.Token = nil
 	}
+}
+
+// RawBody is a request body that Query sends as is instead of encoding it as
+// JSON, for example a multipart file upload.
+type RawBody struct {
+	ContentType string
+	Data        []byte
+}
+
+// Query executes an API request with automatic token refresh
+func (c *APIClient) Query(ctx context.Context, scope, endpoint, operation string, requestData interface{}) (*http.Response, error) {
+	baseURI := c.BaseURI(scope)
 
 	// Handle full URLs or relative paths
 	var url string
@@ -145,91 +240,185 @@ func (c *APIClient) Query(scope, endpoint, operation string, requestData interfa
 		url = fmt.Sprintf("%s/%s", baseURI, endpoint)
 	}
 
-	maxRetries := 10
+	// Marshal the body once, it is re-sent unchanged on every retry
+	var bodyData []byte
+	contentType := "application/json"
+	switch data := requestData.(type) {
+	case nil:
+	case RawBody:
+		bodyData = data.Data
+		contentType = data.ContentType
+	default:
+		var err error
+		bodyData, err = json.Marshal(requestData)
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal request data: %w", err)
+		}
+	}
+
+	policy := c.RetryPolicy
+	if policy == nil {
+		policy = DefaultRetryPolicy()
+	}
+
+	limiter := c.limiter(scope)
+
+	ctx = withLogger(ctx, scope)
+	subsystem := Subsystem(scope)
+
+	start := time.Now()
+	tokenRefreshed := false
 	var lastErr error
 
-	for attempt := 0; attempt < maxRetries; attempt++ {
+	for attempt := 0; ; attempt++ {
+		// Every attempt, retries included, spends from the scope's budget
+		if limiter != nil {
+			if err := limiter.Wait(ctx); err != nil {
+				return nil, err
+			}
+		}
+
 		// Ensure we have a valid token
-		if err := c.ensureToken(); err != nil {
+		accessToken, err := c.ensureToken(ctx)
+		if err != nil {
 			return nil, fmt.Errorf("failed to obtain token: %w", err)
 		}
 
 		// Create request body
 		var body io.Reader
-		if requestData != nil {
-			jsonData, err := json.Marshal(requestData)
-			if err != nil {
-				return nil, fmt.Errorf("failed to marshal request data: %w", err)
-			}
-			body = bytes.NewBuffer(jsonData)
+		if bodyData != nil {
+			body = bytes.NewReader(bodyData)
 		}
 
-		req, err := http.NewRequest(operation, url, body)
+		req, err := http.NewRequestWithContext(ctx, operation, url, body)
 		if err != nil {
 			return nil, fmt.Errorf("failed to create request: %w", err)
 		}
 
 		// Set headers
-		req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", c.Token.AccessToken))
-		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", accessToken))
+		req.Header.Set("Content-Type", contentType)
+
+		tflog.SubsystemDebug(ctx, subsystem, "Sending API request", map[string]interface{}{
+			"http_method":       operation,
+			"http_url":          url,
+			"http_request_body": loggedBody(bodyData),
+			"attempt":           attempt + 1,
+		})
 
 		// Execute request
+		sent := time.Now()
 		resp, err := c.HTTPClient.Do(req)
 		if err != nil {
+			// A cancelled or expired context is final, retrying cannot succeed
+			if ctx.Err() != nil {
+				return nil, fmt.Errorf("request cancelled: %w", ctx.Err())
+			}
 			lastErr = fmt.Errorf("request failed: %w", err)
-			time.Sleep(time.Duration(attempt+1) * time.Second)
+			delay, retry := policy.NextDelay(attempt, time.Since(start), nil)
+			if !retry {
+				return nil, fmt.Errorf("all retry attempts failed: %w", lastErr)
+			}
+			tflog.SubsystemWarn(ctx, subsystem, "API request failed, retrying", map[string]interface{}{
+				"http_method": operation,
+				"http_url":    url,
+				"error":       err.Error(),
+				"retry_in":    delay.String(),
+			})
+			if err := sleepContext(ctx, delay); err != nil {
+				return nil, err
+			}
 			continue
 		}
 
-		// Check for token expiration (401 Unauthorized)
-		if resp.StatusCode == http.StatusUnauthorized && attempt < maxRetries-1 {
-			resp.Body.Close()
+		// Buffer the body so that it can be logged and still be read by the caller
+		respBody, err := io.ReadAll(resp.Body)
+		resp.Body.Close()
+		if err != nil {
+			return nil, fmt.Errorf("failed to read %d response body: %w", resp.StatusCode, err)
+		}
+		resp.Body = io.NopCloser(bytes.NewReader(respBody))
+
+		tflog.SubsystemDebug(ctx, subsystem, "Received API response", map[string]interface{}{
+			"http_method":        operation,
+			"http_url":           url,
+			"http_status_code":   resp.StatusCode,
+			"http_duration_ms":   time.Since(sent).Milliseconds(),
+			"http_request_id":    requestID(resp),
+			"http_response_body": loggedBody(respBody),
+		})
+
+		// Check for token expiration (401 Unauthorized). A second 401 with a
+		// fresh token is a real authorization failure and is returned as is.
+		if resp.StatusCode == http.StatusUnauthorized && !tokenRefreshed {
+			tflog.SubsystemDebug(ctx, subsystem, "Token rejected, refreshing it")
 			// Force token refresh
-			c.Token = nil
+			c.invalidateToken(accessToken)
+			tokenRefreshed = true
 			continue
 		}
 
-		// Check for Rate Limit (429)
-		if resp.StatusCode == http.StatusTooManyRequests && attempt < maxRetries-1 {
-			resp.Body.Close()
-			// Wait for rate limit to reset (simple fixed backoff for now)
-			time.Sleep(10 * time.Second)
-			continue
+		// Check for Rate Limit (429) and Conflict (409) for a locked ruleset.
+		// Conflicts other than a locked ruleset are not transient.
+		retryable := resp.StatusCode == http.StatusTooManyRequests ||
+			(resp.StatusCode == http.StatusConflict && strings.Contains(string(respBody), "locked"))
+		if retryable {
+			if delay, retry := policy.NextDelay(attempt, time.Since(start), resp); retry {
+				tflog.SubsystemWarn(ctx, subsystem, "API request throttled, retrying", map[string]interface{}{
+					"http_method":      operation,
+					"http_url":         url,
+					"http_status_code": resp.StatusCode,
+					"retry_in":         delay.String(),
+				})
+				if err := sleepContext(ctx, delay); err != nil {
+					return nil, err
+				}
+				continue
+			}
+			// Out of retries, the caller reports the status code
 		}
 
-		// Check for Conflict (409) - specifically for locked ruleset
-		if resp.StatusCode == http.StatusConflict && attempt < maxRetries-1 {
-			bodyBytes, readErr := io.ReadAll(resp.Body)
-			resp.Body.Close()
-			if readErr != nil {
-				// If we can't read the body, we can't check for the lock message.
-				// Return the original response (re-constructed? no, we closed it).
-				// Just fail or retry? Let's retry blindly if read fails? No, safer to error.
-				return nil, fmt.Errorf("failed to read 409 response body: %w", readErr)
-			}
+		// Return response (caller should check status code)
+		return resp, nil
+	}
+}
 
-			bodyStr := string(bodyBytes)
-			if strings.Contains(bodyStr, "locked") {
-				// It's a lock error, retry after delay
-				time.Sleep(5 * time.Second)
-				continue
-			}
+// sleepContext waits for d or until ctx is done, whichever comes first.
+func sleepContext(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
 
-			// If not locked, restore the body and return the response
-			resp.Body = io.NopCloser(bytes.NewBuffer(bodyBytes))
-			return resp, nil
-		}
+	select {
+	case <-ctx.Done():
+		return fmt.Errorf("request cancelled: %w", ctx.Err())
+	case <-timer.C:
+		return nil
+	}
+}
 
-		// Add delay for state-changing operations to avoid 409 Conflict (locked ruleset)
-		if operation == OperationPost || operation == OperationPut || operation == OperationPatch || operation == OperationDelete {
-			time.Sleep(2 * time.Second)
+// BaseURI returns the base URI used for requests in the given scope.
+// The {scope} placeholder expands to the scope name, except for the reports
+// scope which is served from "reports.<region>" when a region is set.
+func (c *APIClient) BaseURI(scope string) string {
+	template, ok := c.ScopeURLs[scope]
+	if !ok || template == "" {
+		template = c.BaseURL
+		if template == "" {
+			template = DefaultAPIBaseURL
+		}
+		if !strings.Contains(template, ScopePlaceholder) {
+			template = strings.TrimSuffix(template, "/") + "/" + ScopePlaceholder + "/v2"
 		}
+	}
 
-		// Return response (caller should check status code)
-		return resp, nil
+	scopeSegment := scope
+	if scope == ScopeReports && c.Region != "" {
+		scopeSegment = fmt.Sprintf("%s.%s", scope, c.Region)
 	}
 
-	return nil, fmt.Errorf("all retry attempts failed: %w", lastErr)
+	baseURI := strings.ReplaceAll(template, ScopePlaceholder, scopeSegment)
+	baseURI = strings.ReplaceAll(baseURI, RegionPlaceholder, c.Region)
+	return strings.TrimSuffix(baseURI, "/")
 }
 
 // Helper functions
@@ -248,24 +437,3 @@ func extractID(data map[string]interface{}) string {
 	}
 	return ""
 }
-
-// extractCount attempts to extract item count from response data
-func extractCount(data interface{}) int {
-	switch v := data.(type) {
-	case map[string]interface{}:
-		// Check for count field
-		if count, ok := v["count"].(float64); ok {
-			return int(count)
-		}
-		// Check for data/items arrays
-		if items, ok := v["data"].([]interface{}); ok {
-			return len(items)
-		}
-		if items, ok := v["items"].([]interface{}); ok {
-			return len(items)
-		}
-	case []interface{}:
-		return len(v)
-	}
-	return 0
-}
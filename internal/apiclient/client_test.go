@@ -0,0 +1,142 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package apiclient
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// newTestClient returns a client for an httptest server that issues tokens
+// at /token and serves every other path with api.
+func newTestClient(t *testing.T, api http.HandlerFunc) *APIClient {
+	t.Helper()
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/token", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		io.WriteString(w, `{"access_token":"test-access-token","token_type":"bearer","expires_in":3600}`)
+	})
+	mux.HandleFunc("/", api)
+
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	client := NewAPIClient(server.URL+"/token", "test-client-id", "test-client-secret", nil, "")
+	client.BaseURL = server.URL
+	client.RequestsPerSecond = 0
+	client.RetryPolicy = &BackoffRetryPolicy{MaxRetries: 3, BaseWait: time.Millisecond, MaxWait: time.Millisecond}
+	return client
+}
+
+func TestQueryRetries(t *testing.T) {
+	tests := map[string]struct {
+		status       int
+		body         string
+		wantRequests int32
+		wantStatus   int
+	}{
+		"rate limited": {
+			status:       http.StatusTooManyRequests,
+			wantRequests: 2,
+			wantStatus:   http.StatusOK,
+		},
+		"locked ruleset": {
+			status:       http.StatusConflict,
+			body:         `{"message":"The ruleset is locked"}`,
+			wantRequests: 2,
+			wantStatus:   http.StatusOK,
+		},
+		"other conflict": {
+			status:       http.StatusConflict,
+			body:         `{"message":"A rule with this name already exists"}`,
+			wantRequests: 1,
+			wantStatus:   http.StatusConflict,
+		},
+		"unauthorized": {
+			status:       http.StatusUnauthorized,
+			wantRequests: 2,
+			wantStatus:   http.StatusOK,
+		},
+		"server error": {
+			status:       http.StatusInternalServerError,
+			wantRequests: 1,
+			wantStatus:   http.StatusInternalServerError,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			var requests atomic.Int32
+			client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+				if requests.Add(1) == 1 {
+					w.Header().Set("Retry-After", "0")
+					w.WriteHeader(test.status)
+					io.WriteString(w, test.body)
+					return
+				}
+				io.WriteString(w, `{}`)
+			})
+
+			resp, err := client.Query(context.Background(), ScopePolicies, "rules", OperationGet, nil)
+			if err != nil {
+				t.Fatalf("Query() error = %s", err)
+			}
+			resp.Body.Close()
+
+			if resp.StatusCode != test.wantStatus {
+				t.Errorf("status = %d, want %d", resp.StatusCode, test.wantStatus)
+			}
+			if got := requests.Load(); got != test.wantRequests {
+				t.Errorf("requests = %d, want %d", got, test.wantRequests)
+			}
+		})
+	}
+}
+
+func TestQueryGivesUpAfterMaxRetries(t *testing.T) {
+	var requests atomic.Int32
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		w.Header().Set("Retry-After", "0")
+		w.WriteHeader(http.StatusTooManyRequests)
+	})
+
+	resp, err := client.Query(context.Background(), ScopePolicies, "rules", OperationGet, nil)
+	if err != nil {
+		t.Fatalf("Query() error = %s", err)
+	}
+	resp.Body.Close()
+
+	if resp.StatusCode != http.StatusTooManyRequests {
+		t.Errorf("status = %d, want %d", resp.StatusCode, http.StatusTooManyRequests)
+	}
+	if got := requests.Load(); got != 4 {
+		t.Errorf("requests = %d, want 4", got)
+	}
+}
+
+func TestQueryCancelledDuringRetryWait(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Retry-After", "60")
+		w.WriteHeader(http.StatusTooManyRequests)
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+	_, err := client.Query(ctx, ScopePolicies, "rules", OperationGet, nil)
+	if err == nil {
+		t.Fatal("Query() succeeded, want a cancellation error")
+	}
+	if elapsed := time.Since(start); elapsed > 10*time.Second {
+		t.Errorf("Query() returned after %s, want it to stop waiting when the context is done", elapsed)
+	}
+}
@@ -4,9 +4,9 @@
 package apiclient
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
-	"io"
 	"net/http"
 	"net/url"
 )
@@ -48,28 +48,17 @@ type ConnectorGroupsResponse struct {
 	Offset int              `json:"offset"`
 }
 
-func (c *APIClient) GetConnectorGroups(limit, offset int) ([]ConnectorGroup, error) {
-	endpoint := fmt.Sprintf("connectorGroups?limit=%d&offset=%d", limit, offset)
-	resp, err := c.Query("deployments", endpoint, http.MethodGet, nil)
-	if err != nil {
-		return nil, err
-	}
-	defer resp.Body.Close()
-
-	if resp.StatusCode != http.StatusOK {
-		body, _ := io.ReadAll(resp.Body)
-		return nil, fmt.Errorf("API request failed with status %d: %s", resp.StatusCode, string(body))
-	}
-
-	var result ConnectorGroupsResponse
-	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
-		return nil, fmt.Errorf("failed to decode response: %w", err)
-	}
-
-	return result.Data, nil
+// GetConnectorGroups retrieves all connector groups
+func (c *APIClient) GetConnectorGroups(ctx context.Context) ([]ConnectorGroup, error) {
+	return CollectAll(Paginate[ConnectorGroup](ctx, c, ListRequest{
+		Scope:    ScopeDeployments,
+		Endpoint: "connectorGroups",
+		Style:    PageOffset,
+		Action:   "get connector groups",
+	}))
 }
 
-func (c *APIClient) GetConnectorGroupByName(name string) (*ConnectorGroup, error) {
+func (c *APIClient) GetConnectorGroupByName(ctx context.Context, name string) (*ConnectorGroup, error) {
 	// Construct filter JSON
 	filter := map[string]string{"name": name}
 	filterBytes, err := json.Marshal(filter)
@@ -79,15 +68,14 @@ func (c *APIClient) GetConnectorGroupByName(name string) (*ConnectorGroup, error
 	filterStr := url.QueryEscape(string(filterBytes))
 
 	endpoint := fmt.Sprintf("connectorGroups?filters=%s", filterStr)
-	resp, err := c.Query("deployments", endpoint, http.MethodGet, nil)
+	resp, err := c.Query(ctx, "deployments", endpoint, http.MethodGet, nil)
 	if err != nil {
 		return nil, err
 	}
 	defer resp.Body.Close()
 
 	if resp.StatusCode != http.StatusOK {
-		body, _ := io.ReadAll(resp.Body)
-		return nil, fmt.Errorf("API request failed with status %d: %s", resp.StatusCode, string(body))
+		return nil, NewAPIError(resp, fmt.Sprintf("get connector group %q", name))
 	}
 
 	var result ConnectorGroupsResponse
@@ -103,21 +91,16 @@ func (c *APIClient) GetConnectorGroupByName(name string) (*ConnectorGroup, error
 	return &result.Data[0], nil
 }
 
-func (c *APIClient) GetConnectorGroup(id int) (*ConnectorGroup, error) {
+func (c *APIClient) GetConnectorGroup(ctx context.Context, id int) (*ConnectorGroup, error) {
 	endpoint := fmt.Sprintf("connectorGroups/%d?includeProvisioningKey=true", id)
-	resp, err := c.Query("deployments", endpoint, http.MethodGet, nil)
+	resp, err := c.Query(ctx, "deployments", endpoint, http.MethodGet, nil)
 	if err != nil {
 		return nil, err
 	}
 	defer resp.Body.Close()
 
-	if resp.StatusCode == http.StatusNotFound {
-		return nil, nil
-	}
-
 	if resp.StatusCode != http.StatusOK {
-		body, _ := io.ReadAll(resp.Body)
-		return nil, fmt.Errorf("API request failed with status %d: %s", resp.StatusCode, string(body))
+		return nil, NewAPIError(resp, fmt.Sprintf("get connector group %d", id))
 	}
 
 	var result ConnectorGroup
@@ -128,17 +111,16 @@ func (c *APIClient) GetConnectorGroup(id int) (*ConnectorGroup, error) {
 	return &result, nil
 }
 
-func (c *APIClient) CreateConnectorGroup(req ConnectorGroupCreateRequest) (*ConnectorGroup, error) {
+func (c *APIClient) CreateConnectorGroup(ctx context.Context, req ConnectorGroupCreateRequest) (*ConnectorGroup, error) {
 	endpoint := "connectorGroups"
-	resp, err := c.Query("deployments", endpoint, http.MethodPost, req)
+	resp, err := c.Query(ctx, "deployments", endpoint, http.MethodPost, req)
 	if err != nil {
 		return nil, err
 	}
 	defer resp.Body.Close()
 
 	if resp.StatusCode != http.StatusCreated {
-		body, _ := io.ReadAll(resp.Body)
-		return nil, fmt.Errorf("API request failed with status %d: %s", resp.StatusCode, string(body))
+		return nil, NewAPIError(resp, "create connector group")
 	}
 
 	var result ConnectorGroup
@@ -149,17 +131,16 @@ func (c *APIClient) CreateConnectorGroup(req ConnectorGroupCreateRequest) (*Conn
 	return &result, nil
 }
 
-func (c *APIClient) UpdateConnectorGroup(id int, req ConnectorGroupUpdateRequest) (*ConnectorGroup, error) {
+func (c *APIClient) UpdateConnectorGroup(ctx context.Context, id int, req ConnectorGroupUpdateRequest) (*ConnectorGroup, error) {
 	endpoint := fmt.Sprintf("connectorGroups/%d", id)
-	resp, err := c.Query("deployments", endpoint, http.MethodPut, req)
+	resp, err := c.Query(ctx, "deployments", endpoint, http.MethodPut, req)
 	if err != nil {
 		return nil, err
 	}
 	defer resp.Body.Close()
 
 	if resp.StatusCode != http.StatusOK {
-		body, _ := io.ReadAll(resp.Body)
-		return nil, fmt.Errorf("API request failed with status %d: %s", resp.StatusCode, string(body))
+		return nil, NewAPIError(resp, fmt.Sprintf("update connector group %d", id))
 	}
 
 	var result ConnectorGroup
@@ -170,17 +151,16 @@ func (c *APIClient) UpdateConnectorGroup(id int, req ConnectorGroupUpdateRequest
 	return &result, nil
 }
 
-func (c *APIClient) DeleteConnectorGroup(id int) error {
+func (c *APIClient) DeleteConnectorGroup(ctx context.Context, id int) error {
 	endpoint := fmt.Sprintf("connectorGroups/%d", id)
-	resp, err := c.Query("deployments", endpoint, http.MethodDelete, nil)
+	resp, err := c.Query(ctx, "deployments", endpoint, http.MethodDelete, nil)
 	if err != nil {
 		return err
 	}
 	defer resp.Body.Close()
 
 	if resp.StatusCode != http.StatusNoContent {
-		body, _ := io.ReadAll(resp.Body)
-		return fmt.Errorf("API request failed with status %d: %s", resp.StatusCode, string(body))
+		return NewAPIError(resp, fmt.Sprintf("delete connector group %d", id))
 	}
 
 	return nil
@@ -0,0 +1,228 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package apiclient
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+)
+
+// requestIDHeaders are the response headers that carry the API request ID,
+// in order of preference.
+var requestIDHeaders = []string{"X-Request-Id", "X-Correlation-Id", "X-Amzn-Requestid", "Cf-Ray"}
+
+// APIError is returned when the API answers with an unexpected status code.
+type APIError struct {
+	// Action describes what the client tried to do, e.g. "get access rule 42"
+	Action     string
+	StatusCode int
+	Method     string
+	URL        string
+	RequestID  string
+	// Message is the error message decoded from the response body, or the
+	// raw body when it does not contain one.
+	Message string
+	// OAuthScope is the OAuth scope the request needs, when known. It is
+	// the likely cause of a 403 Forbidden response.
+	OAuthScope string
+}
+
+// NewAPIError builds an APIError from resp and consumes its body.
+func NewAPIError(resp *http.Response, action string) *APIError {
+	var body []byte
+	if resp.Body != nil {
+		body, _ = io.ReadAll(resp.Body)
+	}
+	return newAPIErrorWithBody(resp, body, action)
+}
+
+// newAPIErrorWithBody builds an APIError from resp whose body has already
+// been read into body.
+func newAPIErrorWithBody(resp *http.Response, body []byte, action string) *APIError {
+	apiErr := &APIError{
+		Action:     action,
+		StatusCode: resp.StatusCode,
+	}
+
+	if resp.Request != nil {
+		apiErr.Method = resp.Request.Method
+		if resp.Request.URL != nil {
+			apiErr.URL = resp.Request.URL.Redacted()
+			apiErr.OAuthScope = requiredOAuthScope(resp.Request.Method, resp.Request.URL.Path)
+		}
+	}
+
+	apiErr.RequestID = requestID(resp)
+	apiErr.Message = errorMessage(body)
+	return apiErr
+}
+
+// Error implements error.
+func (e *APIError) Error() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "failed to %s. Status: %d", e.Action, e.StatusCode)
+	if e.Method != "" {
+		fmt.Fprintf(&b, ", Request: %s %s", e.Method, e.URL)
+	}
+	if e.RequestID != "" {
+		fmt.Fprintf(&b, ", Request ID: %s", e.RequestID)
+	}
+	fmt.Fprintf(&b, ", Response: %s", e.Message)
+	if e.StatusCode == http.StatusForbidden && e.OAuthScope != "" {
+		fmt.Fprintf(&b, ". The API key may be missing the %q OAuth scope", e.OAuthScope)
+	}
+	return b.String()
+}
+
+// StatusCode returns the HTTP status code of err if it is or wraps an
+// APIError, and 0 otherwise.
+func StatusCode(err error) int {
+	var apiErr *APIError
+	if errors.As(err, &apiErr) {
+		return apiErr.StatusCode
+	}
+	return 0
+}
+
+// IsNotFound reports whether err is an API 404 Not Found error.
+func IsNotFound(err error) bool {
+	return StatusCode(err) == http.StatusNotFound
+}
+
+// IsConflict reports whether err is an API 409 Conflict error.
+func IsConflict(err error) bool {
+	return StatusCode(err) == http.StatusConflict
+}
+
+// IsForbidden reports whether err is an API 403 Forbidden error.
+func IsForbidden(err error) bool {
+	return StatusCode(err) == http.StatusForbidden
+}
+
+// requestID returns the API request ID of resp, or "" if it has none.
+func requestID(resp *http.Response) string {
+	for _, header := range requestIDHeaders {
+		if v := resp.Header.Get(header); v != "" {
+			return v
+		}
+	}
+	return ""
+}
+
+// errorMessage extracts the error message from an API error body. The APIs
+// are not consistent, so several common shapes are tried before falling back
+// to the raw body.
+func errorMessage(body []byte) string {
+	raw := strings.TrimSpace(string(body))
+
+	var data map[string]interface{}
+	if err := json.Unmarshal(body, &data); err != nil {
+		return raw
+	}
+
+	var messages []string
+	for _, key := range []string{"message", "errorMessage", "error_description", "detail", "description", "error"} {
+		if s, ok := data[key].(string); ok && s != "" {
+			messages = append(messages, s)
+			break
+		}
+	}
+
+	// Validation errors are often returned as a list
+	if list, ok := data["errors"].([]interface{}); ok {
+		for _, item := range list {
+			switch v := item.(type) {
+			case string:
+				messages = append(messages, v)
+			case map[string]interface{}:
+				if s, ok := v["message"].(string); ok && s != "" {
+					if field, ok := v["field"].(string); ok && field != "" {
+						s = field + ": " + s
+					}
+					messages = append(messages, s)
+				}
+			}
+		}
+	}
+
+	if len(messages) == 0 {
+		return raw
+	}
+	return strings.Join(messages, "; ")
+}
+
+// oauthScopePaths maps API path segments to the OAuth scope that guards them.
+// More specific paths come first.
+var oauthScopePaths = []struct {
+	path  string
+	scope string
+}{
+	{"/destinationlists/*/destinations", "policies.destinations"},
+	{"/destinationlists", "policies.destinationlists"},
+	{"/objects/networkObjects", "policies.objects.networkObjects"},
+	{"/objects/serviceObjects", "policies.objects.serviceObjects"},
+	{"/objects/networkObjectGroups", "policies.objects.networkObjectGroups"},
+	{"/objects/serviceObjectGroups", "policies.objects.serviceObjectGroups"},
+	{"/objects/unifiedNetworkObjects", "policies.objects.networkObjects"},
+	{"/objects/unifiedServiceObjects", "policies.objects.serviceObjects"},
+	{"/rules", "policies.rules"},
+	{"/privateResourceGroups", "policies.privateresourcegroups"},
+	{"/privateResources", "policies.privateresources"},
+	{"/securityProfiles", "policies.securityProfiles"},
+	{"/ipsSignatureProfiles", "policies.ipsconfig"},
+	{"/categorySettings", "policies.contentCategories"},
+	{"/applicationCategories", "policies.applicationCategories"},
+	{"/applicationLists", "policies.applicationlists"},
+	{"/tenantControls", "policies.tenantControlsProfiles"},
+	{"/connectorGroups", "deployments.resourceconnectors"},
+	{"/networktunnelgroups", "deployments.networktunnelgroups"},
+	{"/networktunnelgroupsstate", "deployments.networktunnelgroups"},
+	{"/internalnetworks", "deployments.internalnetworks"},
+	{"/internaldomains", "deployments.internaldomains"},
+	{"/sites", "deployments.sites"},
+	{"/regions", "deployments.regions"},
+	{"/networks", "deployments.networks"},
+	{"/appDiscovery", "reports.appDiscovery"},
+	{"/applications", "reports.utilities"},
+	{"/identities", "reports.utilities"},
+}
+
+// requiredOAuthScope returns the OAuth scope needed for method on urlPath, or
+// "" if the path is not known.
+func requiredOAuthScope(method, urlPath string) string {
+	access := "write"
+	if method == OperationGet {
+		access = "read"
+	}
+
+	segments := strings.Split(strings.Trim(urlPath, "/"), "/")
+	for _, entry := range oauthScopePaths {
+		if hasPathSegments(segments, strings.Split(strings.Trim(entry.path, "/"), "/")) {
+			return entry.scope + ":" + access
+		}
+	}
+	return ""
+}
+
+// hasPathSegments reports whether pattern occurs in segments. "*" in pattern
+// matches any single segment.
+func hasPathSegments(segments, pattern []string) bool {
+	for i := 0; i+len(pattern) <= len(segments); i++ {
+		match := true
+		for j, p := range pattern {
+			if p != "*" && p != segments[i+j] {
+				match = false
+				break
+			}
+		}
+		if match {
+			return true
+		}
+	}
+	return false
+}
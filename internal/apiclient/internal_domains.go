@@ -0,0 +1,147 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package apiclient
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"iter"
+	"net/http"
+	"strings"
+)
+
+// Internal Domains API endpoints
+const (
+	InternalDomainsEndpoint       = "internaldomains"
+	InternalDomainDetailsEndpoint = "internaldomains/%d"
+)
+
+// InternalDomain represents a split-DNS internal domain
+type InternalDomain struct {
+	ID                      int64   `json:"id"`
+	Domain                  string  `json:"domain"`
+	Description             string  `json:"description,omitempty"`
+	IncludeAllVAs           bool    `json:"includeAllVAs"`
+	IncludeAllMobileDevices bool    `json:"includeAllMobileDevices"`
+	SiteIDs                 []int64 `json:"siteIds"`
+	CreatedAt               string  `json:"createdAt,omitempty"`
+	ModifiedAt              string  `json:"modifiedAt,omitempty"`
+}
+
+// InternalDomainPayload is the request body to create or update an internal domain.
+// Without site IDs the domain applies to all sites.
+type InternalDomainPayload struct {
+	Domain                  string  `json:"domain"`
+	Description             string  `json:"description,omitempty"`
+	IncludeAllVAs           bool    `json:"includeAllVAs"`
+	IncludeAllMobileDevices bool    `json:"includeAllMobileDevices"`
+	SiteIDs                 []int64 `json:"siteIds,omitempty"`
+}
+
+// GetInternalDomains retrieves all internal domains
+func GetInternalDomains(ctx context.Context, client *APIClient) ([]InternalDomain, error) {
+	return CollectAll(internalDomains(ctx, client))
+}
+
+// internalDomains iterates over the internal domains of the organization.
+func internalDomains(ctx context.Context, client *APIClient) iter.Seq2[InternalDomain, error] {
+	return Paginate[InternalDomain](ctx, client, ListRequest{
+		Scope:    ScopeDeployments,
+		Endpoint: InternalDomainsEndpoint,
+		Style:    PageNumber,
+		Action:   "get internal domains",
+	})
+}
+
+// GetInternalDomainIDByDomain returns the ID of the internal domain with the given domain name
+func GetInternalDomainIDByDomain(ctx context.Context, client *APIClient, domain string) (int64, error) {
+	internalDomain, found, err := FindFirst(internalDomains(ctx, client), func(d InternalDomain) bool {
+		return strings.EqualFold(d.Domain, domain)
+	})
+	if err != nil {
+		return 0, err
+	}
+	if !found {
+		return 0, fmt.Errorf("internal domain '%s' not found", domain)
+	}
+
+	return internalDomain.ID, nil
+}
+
+// GetInternalDomain retrieves an internal domain by ID
+func GetInternalDomain(ctx context.Context, client *APIClient, id int64) (*InternalDomain, error) {
+	resp, err := client.Query(ctx, ScopeDeployments, fmt.Sprintf(InternalDomainDetailsEndpoint, id), OperationGet, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get internal domain: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, NewAPIError(resp, fmt.Sprintf("get internal domain %d", id))
+	}
+
+	var internalDomain InternalDomain
+	if err := json.NewDecoder(resp.Body).Decode(&internalDomain); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return &internalDomain, nil
+}
+
+// CreateInternalDomain creates a new internal domain
+func CreateInternalDomain(ctx context.Context, client *APIClient, payload InternalDomainPayload) (*InternalDomain, error) {
+	resp, err := client.Query(ctx, ScopeDeployments, InternalDomainsEndpoint, OperationPost, payload)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create internal domain: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusCreated {
+		return nil, NewAPIError(resp, "create internal domain")
+	}
+
+	var internalDomain InternalDomain
+	if err := json.NewDecoder(resp.Body).Decode(&internalDomain); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	logDebug(ctx, ScopeDeployments, "Created internal domain", map[string]interface{}{"id": internalDomain.ID, "domain": internalDomain.Domain})
+	return &internalDomain, nil
+}
+
+// UpdateInternalDomain replaces the properties of an internal domain
+func UpdateInternalDomain(ctx context.Context, client *APIClient, id int64, payload InternalDomainPayload) (*InternalDomain, error) {
+	resp, err := client.Query(ctx, ScopeDeployments, fmt.Sprintf(InternalDomainDetailsEndpoint, id), OperationPut, payload)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update internal domain: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, NewAPIError(resp, fmt.Sprintf("update internal domain %d", id))
+	}
+
+	var internalDomain InternalDomain
+	if err := json.NewDecoder(resp.Body).Decode(&internalDomain); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return &internalDomain, nil
+}
+
+// DeleteInternalDomain deletes an internal domain
+func DeleteInternalDomain(ctx context.Context, client *APIClient, id int64) error {
+	resp, err := client.Query(ctx, ScopeDeployments, fmt.Sprintf(InternalDomainDetailsEndpoint, id), OperationDelete, nil)
+	if err != nil {
+		return fmt.Errorf("failed to delete internal domain: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusNoContent {
+		return NewAPIError(resp, fmt.Sprintf("delete internal domain %d", id))
+	}
+
+	return nil
+}
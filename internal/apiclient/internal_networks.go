@@ -0,0 +1,155 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package apiclient
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"iter"
+	"net/http"
+	"net/url"
+)
+
+// Internal Networks API endpoints
+const (
+	InternalNetworksEndpoint       = "internalnetworks"
+	InternalNetworkDetailsEndpoint = "internalnetworks/%d"
+)
+
+// InternalNetwork represents an internal network. It is attached to exactly
+// one of a site, a network or a network tunnel group.
+type InternalNetwork struct {
+	OriginID     int64  `json:"originId"`
+	Name         string `json:"name"`
+	IPAddress    string `json:"ipAddress"`
+	PrefixLength int    `json:"prefixLength"`
+	SiteID       int64  `json:"siteId,omitempty"`
+	SiteName     string `json:"siteName,omitempty"`
+	NetworkID    int64  `json:"networkId,omitempty"`
+	NetworkName  string `json:"networkName,omitempty"`
+	TunnelID     int64  `json:"tunnelId,omitempty"`
+	TunnelName   string `json:"tunnelName,omitempty"`
+	CreatedAt    string `json:"createdAt,omitempty"`
+	ModifiedAt   string `json:"modifiedAt,omitempty"`
+}
+
+// InternalNetworkPayload is the request body to create or update an internal network
+type InternalNetworkPayload struct {
+	Name         string `json:"name"`
+	IPAddress    string `json:"ipAddress"`
+	PrefixLength int    `json:"prefixLength"`
+	SiteID       int64  `json:"siteId,omitempty"`
+	NetworkID    int64  `json:"networkId,omitempty"`
+	TunnelID     int64  `json:"tunnelId,omitempty"`
+}
+
+// GetInternalNetworks retrieves all internal networks
+func GetInternalNetworks(ctx context.Context, client *APIClient) ([]InternalNetwork, error) {
+	return CollectAll(internalNetworks(ctx, client, ""))
+}
+
+// internalNetworks iterates over the internal networks, optionally filtered by
+// name on the server.
+func internalNetworks(ctx context.Context, client *APIClient, name string) iter.Seq2[InternalNetwork, error] {
+	endpoint := InternalNetworksEndpoint
+	if name != "" {
+		endpoint += "?name=" + url.QueryEscape(name)
+	}
+	return Paginate[InternalNetwork](ctx, client, ListRequest{
+		Scope:    ScopeDeployments,
+		Endpoint: endpoint,
+		Style:    PageNumber,
+		Action:   "get internal networks",
+	})
+}
+
+// GetInternalNetworkIDByName returns the origin ID of the internal network with the given name
+func GetInternalNetworkIDByName(ctx context.Context, client *APIClient, name string) (int64, error) {
+	network, found, err := FindFirst(internalNetworks(ctx, client, name), func(n InternalNetwork) bool { return n.Name == name })
+	if err != nil {
+		return 0, err
+	}
+	if !found {
+		return 0, fmt.Errorf("internal network with name '%s' not found", name)
+	}
+
+	return network.OriginID, nil
+}
+
+// GetInternalNetwork retrieves an internal network by its origin ID
+func GetInternalNetwork(ctx context.Context, client *APIClient, id int64) (*InternalNetwork, error) {
+	resp, err := client.Query(ctx, ScopeDeployments, fmt.Sprintf(InternalNetworkDetailsEndpoint, id), OperationGet, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get internal network: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, NewAPIError(resp, fmt.Sprintf("get internal network %d", id))
+	}
+
+	var network InternalNetwork
+	if err := json.NewDecoder(resp.Body).Decode(&network); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return &network, nil
+}
+
+// CreateInternalNetwork creates a new internal network
+func CreateInternalNetwork(ctx context.Context, client *APIClient, payload InternalNetworkPayload) (*InternalNetwork, error) {
+	resp, err := client.Query(ctx, ScopeDeployments, InternalNetworksEndpoint, OperationPost, payload)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create internal network: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusCreated {
+		return nil, NewAPIError(resp, "create internal network")
+	}
+
+	var network InternalNetwork
+	if err := json.NewDecoder(resp.Body).Decode(&network); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	logDebug(ctx, ScopeDeployments, "Created internal network", map[string]interface{}{"origin_id": network.OriginID, "name": network.Name})
+	return &network, nil
+}
+
+// UpdateInternalNetwork replaces the properties of an internal network
+func UpdateInternalNetwork(ctx context.Context, client *APIClient, id int64, payload InternalNetworkPayload) (*InternalNetwork, error) {
+	resp, err := client.Query(ctx, ScopeDeployments, fmt.Sprintf(InternalNetworkDetailsEndpoint, id), OperationPut, payload)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update internal network: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, NewAPIError(resp, fmt.Sprintf("update internal network %d", id))
+	}
+
+	var network InternalNetwork
+	if err := json.NewDecoder(resp.Body).Decode(&network); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return &network, nil
+}
+
+// DeleteInternalNetwork deletes an internal network
+func DeleteInternalNetwork(ctx context.Context, client *APIClient, id int64) error {
+	resp, err := client.Query(ctx, ScopeDeployments, fmt.Sprintf(InternalNetworkDetailsEndpoint, id), OperationDelete, nil)
+	if err != nil {
+		return fmt.Errorf("failed to delete internal network: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusNoContent {
+		return NewAPIError(resp, fmt.Sprintf("delete internal network %d", id))
+	}
+
+	return nil
+}
@@ -4,67 +4,194 @@
 package apiclient
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
-	"io"
+	"iter"
 	"net/http"
+	"net/url"
+)
+
+// IPS Profiles API endpoints
+const (
+	IPSProfilesEndpoint          = "ipsSignatureProfiles"
+	IPSProfileDetailsEndpoint    = "ipsSignatureProfiles/%d"
+	IPSProfileSignaturesEndpoint = "ipsSignatureProfiles/%d/signatures"
 )
 
 type IPSProfile struct {
-	ID             int    `json:"id"`
-	OrganizationID int    `json:"organizationId"`
-	Name           string `json:"name"`
-	IsDefault      bool   `json:"isDefault"`
-	SystemMode     string `json:"systemMode"`
-	CreatedAt      string `json:"createdAt"`
-	ModifiedAt     string `json:"modifiedAt"`
+	ID             int      `json:"id"`
+	OrganizationID int      `json:"organizationId"`
+	Name           string   `json:"name"`
+	IsDefault      bool     `json:"isDefault"`
+	SystemMode     string   `json:"systemMode"`
+	ApplyTo        string   `json:"applyTo,omitempty"`
+	BlockList      []string `json:"blockList,omitempty"`
+	WarnList       []string `json:"warnList,omitempty"`
+	IgnoreList     []string `json:"ignoreList,omitempty"`
+	CreatedAt      string   `json:"createdAt"`
+	ModifiedAt     string   `json:"modifiedAt"`
+}
+
+// IPSProfilePayload is the request body to create a custom IPS profile. The
+// signature lists hold GID-SID pairs such as "1-28423"; a signature may only
+// be in one of them.
+type IPSProfilePayload struct {
+	Name       string   `json:"name"`
+	SystemMode string   `json:"systemMode"`
+	ApplyTo    string   `json:"applyTo"`
+	BlockList  []string `json:"blockList"`
+	WarnList   []string `json:"warnList"`
+	IgnoreList []string `json:"ignoreList"`
 }
 
-type IPSProfilesResponse struct {
-	Data []IPSProfile `json:"data"`
-	Meta struct {
-		Page  int `json:"page"`
-		Limit int `json:"limit"`
-		Total int `json:"total"`
-	} `json:"meta"`
+// IPSProfilePatch is a single operation of an IPS profile update. Op is add,
+// replace or remove and Path one of /name, /systemMode, /blockList, /warnList
+// or /ignoreList.
+type IPSProfilePatch struct {
+	Op    string      `json:"op"`
+	Path  string      `json:"path"`
+	Value interface{} `json:"value"`
 }
 
-func (c *APIClient) GetIPSProfiles() ([]IPSProfile, error) {
-	endpoint := "ipsSignatureProfiles"
-	// The scope for IPS profiles is policies.ipsconfig:read
-	// We need to make sure this scope is included in the token request if not already.
-	// However, the client handles scopes globally. Assuming the user has configured the correct scopes.
-	// Based on provider.go, "policies.ipsconfig:read" is NOT currently in the default scopes list.
-	// We might need to add it to provider.go later.
-
-	// For now, let's assume the client has the scope or we use a generic scope if possible,
-	// but the spec says "policies.ipsconfig:read".
-	// Let's use ScopePolicies as a base, but the actual scope string is specific.
-	// The client.Query method takes a scope category (e.g. "policies") to find the base URL?
-	// No, client.Query takes 'scope' which maps to the base URL key in client.go.
-	// "policies" -> "https://api.sse.cisco.com/policies/v2"
-	// So passing ScopePolicies is correct for the URL construction.
-
-	resp, err := c.Query(ScopePolicies, endpoint, http.MethodGet, nil)
+// IPSSignature is a signature of an IPS profile. CurrentAction and
+// OriginalAction are only returned for overridden signatures.
+type IPSSignature struct {
+	ID             string   `json:"id"`
+	GID            int64    `json:"gid"`
+	SID            int64    `json:"sid"`
+	Name           string   `json:"name"`
+	CVE            []string `json:"cve"`
+	Description    string   `json:"description"`
+	CurrentAction  *string  `json:"currentAction"`
+	OriginalAction *string  `json:"originalAction"`
+}
+
+// IPSSignatureFilters are the filters of GetIPSProfileSignatures. Without an
+// action the API only returns the signatures that block.
+type IPSSignatureFilters struct {
+	Action        string `json:"action,omitempty"`
+	SearchStr     string `json:"searchStr,omitempty"`
+	OverridesOnly bool   `json:"overridesOnly,omitempty"`
+}
+
+func (c *APIClient) GetIPSProfiles(ctx context.Context) ([]IPSProfile, error) {
+	// Requires the policies.ipsconfig:read OAuth scope.
+	return CollectAll(ipsProfiles(ctx, c))
+}
+
+// ipsProfiles iterates over the IPS profiles of the organization.
+func ipsProfiles(ctx context.Context, client *APIClient) iter.Seq2[IPSProfile, error] {
+	return Paginate[IPSProfile](ctx, client, ListRequest{
+		Scope:    ScopePolicies,
+		Endpoint: IPSProfilesEndpoint,
+		Style:    PageNumber,
+		Action:   "get IPS profiles",
+	})
+}
+
+// GetIPSProfileIDByName returns the ID of the IPS profile with the given name
+func GetIPSProfileIDByName(ctx context.Context, client *APIClient, name string) (int64, error) {
+	profile, found, err := FindFirst(ipsProfiles(ctx, client), func(p IPSProfile) bool { return p.Name == name })
 	if err != nil {
-		return nil, err
+		return 0, err
+	}
+	if !found {
+		return 0, fmt.Errorf("IPS profile with name '%s' not found", name)
+	}
+
+	return int64(profile.ID), nil
+}
+
+// GetIPSProfile retrieves an IPS profile and its signature overrides by ID
+func GetIPSProfile(ctx context.Context, client *APIClient, id int64) (*IPSProfile, error) {
+	resp, err := client.Query(ctx, ScopePolicies, fmt.Sprintf(IPSProfileDetailsEndpoint, id), OperationGet, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get IPS profile: %w", err)
 	}
 	defer resp.Body.Close()
 
 	if resp.StatusCode != http.StatusOK {
-		body, _ := io.ReadAll(resp.Body)
-		return nil, fmt.Errorf("API request failed with status %d: %s", resp.StatusCode, string(body))
+		return nil, NewAPIError(resp, fmt.Sprintf("get IPS profile %d", id))
+	}
+
+	var profile IPSProfile
+	if err := json.NewDecoder(resp.Body).Decode(&profile); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return &profile, nil
+}
+
+// CreateIPSProfile creates a new custom IPS profile
+func CreateIPSProfile(ctx context.Context, client *APIClient, payload IPSProfilePayload) (*IPSProfile, error) {
+	resp, err := client.Query(ctx, ScopePolicies, IPSProfilesEndpoint, OperationPost, payload)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create IPS profile: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusCreated {
+		return nil, NewAPIError(resp, fmt.Sprintf("create IPS profile '%s'", payload.Name))
+	}
+
+	var profile IPSProfile
+	if err := json.NewDecoder(resp.Body).Decode(&profile); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
 	}
 
-	body, err := io.ReadAll(resp.Body)
+	logDebug(ctx, ScopePolicies, "Created IPS profile", map[string]interface{}{"ips_profile_id": profile.ID, "ips_profile_name": profile.Name})
+	return &profile, nil
+}
+
+// UpdateIPSProfile applies patch operations to an IPS profile
+func UpdateIPSProfile(ctx context.Context, client *APIClient, id int64, patches []IPSProfilePatch) (*IPSProfile, error) {
+	resp, err := client.Query(ctx, ScopePolicies, fmt.Sprintf(IPSProfileDetailsEndpoint, id), OperationPatch, patches)
 	if err != nil {
-		return nil, fmt.Errorf("failed to read response body: %w", err)
+		return nil, fmt.Errorf("failed to update IPS profile: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, NewAPIError(resp, fmt.Sprintf("update IPS profile %d", id))
 	}
 
-	var result IPSProfilesResponse
-	if err := json.Unmarshal(body, &result); err != nil {
+	var profile IPSProfile
+	if err := json.NewDecoder(resp.Body).Decode(&profile); err != nil {
 		return nil, fmt.Errorf("failed to decode response: %w", err)
 	}
 
-	return result.Data, nil
+	return &profile, nil
+}
+
+// DeleteIPSProfile deletes a custom IPS profile
+func DeleteIPSProfile(ctx context.Context, client *APIClient, id int64) error {
+	resp, err := client.Query(ctx, ScopePolicies, fmt.Sprintf(IPSProfileDetailsEndpoint, id), OperationDelete, nil)
+	if err != nil {
+		return fmt.Errorf("failed to delete IPS profile: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusNoContent {
+		return NewAPIError(resp, fmt.Sprintf("delete IPS profile %d", id))
+	}
+
+	return nil
+}
+
+// GetIPSProfileSignatures retrieves the signatures of an IPS profile that
+// match filters
+func GetIPSProfileSignatures(ctx context.Context, client *APIClient, id int64, filters IPSSignatureFilters) ([]IPSSignature, error) {
+	endpoint := fmt.Sprintf(IPSProfileSignaturesEndpoint, id)
+	if filters != (IPSSignatureFilters{}) {
+		query, _ := json.Marshal(filters)
+		endpoint += "?filters=" + url.QueryEscape(string(query))
+	}
+
+	return CollectAll(Paginate[IPSSignature](ctx, client, ListRequest{
+		Scope:    ScopePolicies,
+		Endpoint: endpoint,
+		Style:    PageNumber,
+		Action:   "get IPS signatures",
+	}))
 }
@@ -0,0 +1,76 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package apiclient
+
+import (
+	"context"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// maxLoggedBodySize caps the request and response bodies written to the log.
+const maxLoggedBodySize = 16 * 1024
+
+// jsonString matches a JSON string literal, including escaped quotes.
+const jsonString = `"(?:[^"\\]|\\.)*"`
+
+// secretPatterns match credentials in log messages and field values. The whole
+// match is replaced with "***".
+var secretPatterns = []*regexp.Regexp{
+	regexp.MustCompile(`(?i)bearer\s+[A-Za-z0-9\-._~+/]+=*`),
+	regexp.MustCompile(`(?i)"(access_token|refresh_token|client_secret|password|passphrase|psk|secret)"\s*:\s*` + jsonString),
+	regexp.MustCompile(`(?i)(client_secret|access_token)=[^&\s]+`),
+	// JSON Patch operations carry the secret in "value" next to its path,
+	// e.g. {"op":"replace","path":"/passphrase","value":"..."}.
+	regexp.MustCompile(`(?i)"path"\s*:\s*"/passphrase"\s*,\s*"value"\s*:\s*` + jsonString),
+	regexp.MustCompile(`(?i)"value"\s*:\s*` + jsonString + `\s*,\s*"path"\s*:\s*"/passphrase"`),
+}
+
+// secretFieldKeys are log fields whose values are always masked.
+var secretFieldKeys = []string{"authorization", "client_secret", "access_token"}
+
+// Subsystem returns the tflog subsystem that logs requests to the API scope,
+// e.g. "api_policies". Its level can be set with TF_LOG_PROVIDER_SSE_API_<SCOPE>.
+func Subsystem(scope string) string {
+	return "api_" + scope
+}
+
+// withLogger returns ctx with the logging subsystem of scope, masking secrets.
+func withLogger(ctx context.Context, scope string) context.Context {
+	subsystem := Subsystem(scope)
+	ctx = tflog.NewSubsystem(ctx, subsystem, tflog.WithLevelFromEnv("TF_LOG_PROVIDER_SSE", subsystem))
+	ctx = tflog.SubsystemMaskFieldValuesWithFieldKeys(ctx, subsystem, secretFieldKeys...)
+	return tflog.SubsystemMaskLogRegexes(ctx, subsystem, secretPatterns...)
+}
+
+// logDebug writes a debug message to the logging subsystem of scope.
+func logDebug(ctx context.Context, scope, msg string, fields ...map[string]interface{}) {
+	tflog.SubsystemDebug(withLogger(ctx, scope), Subsystem(scope), msg, fields...)
+}
+
+// logWarn writes a warning to the logging subsystem of scope.
+func logWarn(ctx context.Context, scope, msg string, fields ...map[string]interface{}) {
+	tflog.SubsystemWarn(withLogger(ctx, scope), Subsystem(scope), msg, fields...)
+}
+
+// loggedBody returns body as a string for logging, truncated to
+// maxLoggedBodySize. Secrets are masked before the body is cut, because the
+// patterns cannot match a secret whose closing quote was cut off.
+func loggedBody(body []byte) string {
+	masked := maskSecrets(string(body))
+	if len(masked) > maxLoggedBodySize {
+		return masked[:maxLoggedBodySize] + "...(truncated)"
+	}
+	return masked
+}
+
+// maskSecrets replaces every match of secretPatterns in s with "***", as the
+// log subsystems do for messages and field values.
+func maskSecrets(s string) string {
+	for _, pattern := range secretPatterns {
+		s = pattern.ReplaceAllString(s, "***")
+	}
+	return s
+}
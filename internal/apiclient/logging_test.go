@@ -0,0 +1,164 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package apiclient
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-log/tflogtest"
+)
+
+func TestLogDebugMasksSecrets(t *testing.T) {
+	tests := map[string]struct {
+		body   string
+		secret string
+	}{
+		"passphrase patch": {
+			body:   `[{"op":"replace","path":"/passphrase","value":"TfSecretPassphrase123"}]`,
+			secret: "TfSecretPassphrase123",
+		},
+		"passphrase patch value first": {
+			body:   `[{"op":"replace","value":"TfSecretPassphrase123","path":"/passphrase"}]`,
+			secret: "TfSecretPassphrase123",
+		},
+		"passphrase property": {
+			body:   `{"name":"branch","passphrase":"TfSecretPassphrase123"}`,
+			secret: "TfSecretPassphrase123",
+		},
+		"escaped quote": {
+			body:   `{"client_secret":"abc\"TfSecretTail"}`,
+			secret: "TfSecretTail",
+		},
+		"access token": {
+			body:   `{"access_token":"eyJhbGciOiJSUzI1NiJ9.payload","token_type":"bearer"}`,
+			secret: "eyJhbGciOiJSUzI1NiJ9",
+		},
+		"form body": {
+			body:   `grant_type=client_credentials&client_secret=TfSecretForm`,
+			secret: "TfSecretForm",
+		},
+		"bearer header": {
+			body:   `Authorization: Bearer TfSecretBearer.abc`,
+			secret: "TfSecretBearer",
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			var output bytes.Buffer
+			ctx := tflogtest.RootLogger(context.Background(), &output)
+
+			logDebug(ctx, ScopeDeployments, "Sending API request", map[string]interface{}{
+				"http_request_body": test.body,
+			})
+
+			if output.Len() == 0 {
+				t.Fatal("nothing was logged")
+			}
+			if strings.Contains(output.String(), test.secret) {
+				t.Errorf("secret %q was logged: %s", test.secret, output.String())
+			}
+		})
+	}
+}
+
+func TestLogDebugKeepsOtherPatches(t *testing.T) {
+	var output bytes.Buffer
+	ctx := tflogtest.RootLogger(context.Background(), &output)
+
+	body := `[{"op":"replace","path":"/name","value":"branch-office"}]`
+	logDebug(ctx, ScopeDeployments, "Sending API request", map[string]interface{}{
+		"http_request_body": body,
+	})
+
+	entries, err := tflogtest.MultilineJSONDecode(&output)
+	if err != nil {
+		t.Fatalf("decoding log output: %s", err)
+	}
+	if len(entries) != 1 {
+		t.Fatalf("got %d log entries, want 1", len(entries))
+	}
+	if got := entries[0]["http_request_body"]; got != body {
+		got, _ := json.Marshal(got)
+		t.Errorf("http_request_body = %s, want %s", got, body)
+	}
+}
+
+func TestQueryLogsMaskedBodies(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		io.WriteString(w, `{"id":42,"name":"branch","passphrase":"TfSecretResponse123"}`)
+	})
+
+	var output bytes.Buffer
+	ctx := tflogtest.RootLogger(context.Background(), &output)
+
+	patches := []NetworkTunnelGroupPatch{
+		{Op: "replace", Path: "/name", Value: "branch"},
+		{Op: "replace", Path: "/passphrase", Value: "TfSecretRequest123"},
+	}
+	resp, err := client.Query(ctx, ScopeDeployments, "networktunnelgroups/42", OperationPatch, patches)
+	if err != nil {
+		t.Fatalf("Query() error = %s", err)
+	}
+	resp.Body.Close()
+
+	entries, err := tflogtest.MultilineJSONDecode(&output)
+	if err != nil {
+		t.Fatalf("decoding log output: %s", err)
+	}
+
+	var request, response map[string]interface{}
+	for _, entry := range entries {
+		switch entry["@message"] {
+		case "Sending API request":
+			request = entry
+		case "Received API response":
+			response = entry
+		}
+	}
+	if request == nil || response == nil {
+		t.Fatalf("request or response not logged: %v", entries)
+	}
+
+	if body, _ := request["http_request_body"].(string); !strings.Contains(body, `"path":"/name","value":"branch"`) {
+		t.Errorf("http_request_body = %q, want the /name patch to be kept", body)
+	}
+	if body, _ := response["http_response_body"].(string); !strings.Contains(body, `"name":"branch"`) {
+		t.Errorf("http_response_body = %q, want the name to be kept", body)
+	}
+
+	for _, secret := range []string{"TfSecretRequest123", "TfSecretResponse123", "test-access-token", "test-client-secret"} {
+		if strings.Contains(output.String(), secret) {
+			t.Errorf("secret %q was logged", secret)
+		}
+	}
+}
+
+func TestLoggedBodyMasksSecretsBeforeTruncating(t *testing.T) {
+	for _, key := range []string{"passphrase", "client_secret"} {
+		t.Run(key, func(t *testing.T) {
+			// The secret value starts before and ends after the cut
+			prefix := `{"padding":"` + strings.Repeat("x", maxLoggedBodySize-len(`{"padding":"`)-len(`","`+key+`":"TfSecret`)) + `","` + key + `":"`
+			body := prefix + `TfSecretStraddlingTheCut123"}`
+			if len(prefix) >= maxLoggedBodySize || len(body) <= maxLoggedBodySize {
+				t.Fatalf("the secret does not straddle the cut: prefix %d, body %d bytes", len(prefix), len(body))
+			}
+
+			got := loggedBody([]byte(body))
+			if strings.Contains(got, "TfSecret") {
+				t.Errorf("loggedBody() leaks the secret: ...%s", got[len(got)-64:])
+			}
+			if len(got) > maxLoggedBodySize+len("...(truncated)") {
+				t.Errorf("loggedBody() returned %d bytes, want at most %d", len(got), maxLoggedBodySize+len("...(truncated)"))
+			}
+		})
+	}
+}
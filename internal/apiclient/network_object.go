@@ -4,11 +4,12 @@
 package apiclient
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
-	"io"
+	"iter"
 	"net/http"
-	"strconv"
+	"strings"
 )
 
 // Network Objects API endpoints
@@ -17,8 +18,18 @@ const (
 	NetworkObjectDetailsEndpoint           = "objects/networkObjects/%s"
 	NetworkObjectsReferencesEndpoint       = "objects/networkObjects/references"
 	NetworkObjectReferencesDetailsEndpoint = "objects/networkObjects/%s/references"
+	NetworkObjectsUploadEndpoint           = "objects/networkObjects/upload"
+	NetworkObjectsValidateEndpoint         = "objects/networkObjects/validate"
 )
 
+// networkObjectsCSVHeader are the columns of the CSV file sent to the upload
+// endpoint. The API specification only describes the body as a CSV file, so
+// the columns are assumed to mirror the fields of the validate request, with
+// the addresses comma separated in value. Every upload has the validate
+// endpoint check the file first and fails with ErrUnsupportedCSVLayout if the
+// API does not understand it.
+var networkObjectsCSVHeader = []string{"name", "description", "type", "value"}
+
 type NetworkObjectValue struct {
 	Addresses []string `json:"addresses"`
 	Type      string   `json:"type"`
@@ -37,78 +48,48 @@ type NetworkObjectPayload struct {
 	Description string      `json:"description,omitempty"`
 }
 
-func GetNetworkObjects(client *APIClient) (interface{}, error) {
-	resp, err := client.Query(ScopePolicies, NetworkObjectsEndpoint, OperationGet, nil)
+// GetNetworkObjects retrieves all network objects. Objects are returned as
+// decoded JSON because their value differs between object types.
+func GetNetworkObjects(ctx context.Context, client *APIClient) ([]map[string]interface{}, error) {
+	objects, err := CollectAll(networkObjects(ctx, client))
 	if err != nil {
-		return nil, fmt.Errorf("failed to get network objects: %w", err)
-	}
-	defer resp.Body.Close()
-
-	if resp.StatusCode != http.StatusOK {
-		body, _ := io.ReadAll(resp.Body)
-		return nil, fmt.Errorf("failed to get network objects. Status: %d, Response: %s", resp.StatusCode, string(body))
+		return nil, err
 	}
 
-	var data interface{}
-	if err := json.NewDecoder(resp.Body).Decode(&data); err != nil {
-		return nil, fmt.Errorf("failed to decode response: %w", err)
-	}
+	logDebug(ctx, ScopePolicies, "Retrieved network objects", map[string]interface{}{"count": len(objects)})
+	return objects, nil
+}
 
-	count := extractCount(data)
-	fmt.Printf("Success. GET %s, retrieved %d objects\n", NetworkObjectsEndpoint, count)
-	return data, nil
+// networkObjects iterates over the network objects of the organization.
+func networkObjects(ctx context.Context, client *APIClient) iter.Seq2[map[string]interface{}, error] {
+	return Paginate[map[string]interface{}](ctx, client, ListRequest{
+		Scope:    ScopePolicies,
+		Endpoint: NetworkObjectsEndpoint,
+		Style:    PageOffset,
+		Action:   "get network objects",
+	})
 }
 
-func GetNetworkObjectIDByName(client *APIClient, name string) (string, error) {
-	data, err := GetNetworkObjects(client)
+func GetNetworkObjectIDByName(ctx context.Context, client *APIClient, name string) (string, error) {
+	obj, found, err := FindFirst(networkObjects(ctx, client), func(o map[string]interface{}) bool {
+		objName, _ := o["name"].(string)
+		return objName == name
+	})
 	if err != nil {
 		return "", err
 	}
-
-	m, ok := data.(map[string]interface{})
-	if !ok {
-		return "", fmt.Errorf("unexpected response format")
+	if !found {
+		return "", fmt.Errorf("network object with name '%s' not found", name)
 	}
 
-	var results []interface{}
-	if r, ok := m["results"].([]interface{}); ok {
-		results = r
-	} else if d, ok := m["data"].([]interface{}); ok {
-		results = d
-	} else {
-		return "", fmt.Errorf("no results found in response")
+	id := extractID(obj)
+	if id == "" {
+		return "", fmt.Errorf("unknown ID format for object %s", name)
 	}
-
-	for _, item := range results {
-		obj, ok := item.(map[string]interface{})
-		if !ok {
-			continue
-		}
-
-		objName, ok := obj["name"].(string)
-		if !ok {
-			continue
-		}
-
-		if objName == name {
-			idVal := obj["id"]
-			switch v := idVal.(type) {
-			case float64:
-				return strconv.FormatInt(int64(v), 10), nil
-			case string:
-				return v, nil
-			case int:
-				return strconv.Itoa(v), nil
-			default:
-				return "", fmt.Errorf("unknown ID format for object %s", name)
-			}
-		}
-	}
-
-	return "", fmt.Errorf("network object with name '%s' not found", name)
+	return id, nil
 }
 
-func PostNetworkObject(client *APIClient, name string, value interface{}, description string) (string, error) {
+func PostNetworkObject(ctx context.Context, client *APIClient, name string, value interface{}, description string) (string, error) {
 	if err := ValidateNetworkObject(name, value); err != nil {
 		return "", fmt.Errorf("validation failed: %w", err)
 	}
@@ -117,14 +98,13 @@ func PostNetworkObject(client *APIClient, name string, value interface{}, descri
 		Value:       value,
 		Description: description,
 	}
-	resp, err := client.Query(ScopePolicies, NetworkObjectsEndpoint, OperationPost, payload)
+	resp, err := client.Query(ctx, ScopePolicies, NetworkObjectsEndpoint, OperationPost, payload)
 	if err != nil {
 		return "", fmt.Errorf("failed to create network object: %w", err)
 	}
 	defer resp.Body.Close()
 	if resp.StatusCode != http.StatusCreated {
-		body, _ := io.ReadAll(resp.Body)
-		return "", fmt.Errorf("failed to create network object '%s'. Status: %d, Response: %s", name, resp.StatusCode, string(body))
+		return "", NewAPIError(resp, fmt.Sprintf("create network object '%s'", name))
 	}
 	var result map[string]interface{}
 	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
@@ -134,38 +114,29 @@ func PostNetworkObject(client *APIClient, name string, value interface{}, descri
 	if objectID == "" {
 		return "", fmt.Errorf("no ID returned in response")
 	}
-	fmt.Printf("Success. Created network object '%s' with ID: %s\n", name, objectID)
+	logDebug(ctx, ScopePolicies, "Created network object", map[string]interface{}{"object_id": objectID, "object_name": name})
 	return objectID, nil
 }
 
-func GetNetworkObjectDetails(client *APIClient, objectID string) (interface{}, error) {
+func GetNetworkObjectDetails(ctx context.Context, client *APIClient, objectID string) (interface{}, error) {
 	endpoint := fmt.Sprintf(NetworkObjectDetailsEndpoint, objectID)
-	resp, err := client.Query(ScopePolicies, endpoint, OperationGet, nil)
+	resp, err := client.Query(ctx, ScopePolicies, endpoint, OperationGet, nil)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get network object details: %w", err)
 	}
 	defer resp.Body.Close()
-	if resp.StatusCode == http.StatusNotFound {
-		return nil, nil
-	}
-	if resp.StatusCode == http.StatusForbidden {
-		// Try to read body to see if there is a useful error message
-		body, _ := io.ReadAll(resp.Body)
-		return nil, fmt.Errorf("access forbidden (403) for object ID %s. Body: %s", objectID, string(body))
-	}
 	if resp.StatusCode != http.StatusOK {
-		body, _ := io.ReadAll(resp.Body)
-		return nil, fmt.Errorf("failed to get network object %s. Status: %d, Response: %s", objectID, resp.StatusCode, string(body))
+		return nil, NewAPIError(resp, fmt.Sprintf("get network object %s", objectID))
 	}
 	var data interface{}
 	if err := json.NewDecoder(resp.Body).Decode(&data); err != nil {
 		return nil, fmt.Errorf("failed to decode response: %w", err)
 	}
-	fmt.Printf("Success. Retrieved network object %s\n", objectID)
+	logDebug(ctx, ScopePolicies, "Retrieved network object", map[string]interface{}{"object_id": objectID})
 	return data, nil
 }
 
-func PutNetworkObjectDetails(client *APIClient, objectID, name string, value interface{}, description string) error {
+func PutNetworkObjectDetails(ctx context.Context, client *APIClient, objectID, name string, value interface{}, description string) error {
 	if err := ValidateNetworkObject(name, value); err != nil {
 		return fmt.Errorf("validation failed: %w", err)
 	}
@@ -175,156 +146,140 @@ func PutNetworkObjectDetails(client *APIClient, objectID, name string, value int
 		Value:       value,
 		Description: description,
 	}
-	resp, err := client.Query(ScopePolicies, endpoint, OperationPut, payload)
+	resp, err := client.Query(ctx, ScopePolicies, endpoint, OperationPut, payload)
 	if err != nil {
 		return fmt.Errorf("failed to update network object: %w", err)
 	}
 	defer resp.Body.Close()
 	if resp.StatusCode != http.StatusOK {
-		body, _ := io.ReadAll(resp.Body)
-		return fmt.Errorf("failed to update network object %s. Status: %d, Response: %s", objectID, resp.StatusCode, string(body))
+		return NewAPIError(resp, fmt.Sprintf("update network object %s", objectID))
 	}
-	fmt.Printf("Success. Updated network object %s\n", objectID)
+	logDebug(ctx, ScopePolicies, "Updated network object", map[string]interface{}{"object_id": objectID})
 	return nil
 }
 
-func DeleteNetworkObject(client *APIClient, objectID string) error {
+func DeleteNetworkObject(ctx context.Context, client *APIClient, objectID string) error {
 	endpoint := fmt.Sprintf(NetworkObjectDetailsEndpoint, objectID)
-	resp, err := client.Query(ScopePolicies, endpoint, OperationDelete, nil)
+	resp, err := client.Query(ctx, ScopePolicies, endpoint, OperationDelete, nil)
 	if err != nil {
 		return fmt.Errorf("failed to delete network object: %w", err)
 	}
 	defer resp.Body.Close()
 	if resp.StatusCode != http.StatusNoContent {
-		body, _ := io.ReadAll(resp.Body)
-		return fmt.Errorf("failed to delete network object %s. Status: %d, Response: %s", objectID, resp.StatusCode, string(body))
+		return NewAPIError(resp, fmt.Sprintf("delete network object %s", objectID))
 	}
-	fmt.Printf("Successfully deleted network object with ID: %s\n", objectID)
+	logDebug(ctx, ScopePolicies, "Deleted network object", map[string]interface{}{"object_id": objectID})
 	return nil
 }
 
-func GetNetworkObjectsReferences(client *APIClient) (interface{}, error) {
-	resp, err := client.Query(ScopePolicies, NetworkObjectsReferencesEndpoint, OperationGet, nil)
+func GetNetworkObjectsReferences(ctx context.Context, client *APIClient) (interface{}, error) {
+	resp, err := client.Query(ctx, ScopePolicies, NetworkObjectsReferencesEndpoint, OperationGet, nil)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get network objects references: %w", err)
 	}
 	defer resp.Body.Close()
 	if resp.StatusCode != http.StatusOK {
-		body, _ := io.ReadAll(resp.Body)
-		return nil, fmt.Errorf("failed to get references for network objects. Status: %d, Response: %s", resp.StatusCode, string(body))
+		return nil, NewAPIError(resp, "get references for network objects")
 	}
 	var data interface{}
 	if err := json.NewDecoder(resp.Body).Decode(&data); err != nil {
 		return nil, fmt.Errorf("failed to decode response: %w", err)
 	}
-	fmt.Println("Success. Retrieved network objects references")
+	logDebug(ctx, ScopePolicies, "Retrieved network object references")
 	return data, nil
 }
 
-func GetNetworkObjectReferences(client *APIClient, objectID string) (interface{}, error) {
+func GetNetworkObjectReferences(ctx context.Context, client *APIClient, objectID string) (interface{}, error) {
 	endpoint := fmt.Sprintf(NetworkObjectReferencesDetailsEndpoint, objectID)
-	resp, err := client.Query(ScopePolicies, endpoint, OperationGet, nil)
+	resp, err := client.Query(ctx, ScopePolicies, endpoint, OperationGet, nil)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get network object references: %w", err)
 	}
 	defer resp.Body.Close()
 	if resp.StatusCode != http.StatusOK {
-		body, _ := io.ReadAll(resp.Body)
-		return nil, fmt.Errorf("failed to get references for network object %s. Status: %d, Response: %s", objectID, resp.StatusCode, string(body))
+		return nil, NewAPIError(resp, fmt.Sprintf("get references for network object %s", objectID))
 	}
 	var data interface{}
 	if err := json.NewDecoder(resp.Body).Decode(&data); err != nil {
 		return nil, fmt.Errorf("failed to decode response: %w", err)
 	}
-	fmt.Printf("Success. Retrieved references for network object %s\n", objectID)
+	logDebug(ctx, ScopePolicies, "Retrieved network object references", map[string]interface{}{"object_id": objectID})
 	return data, nil
 }
 
-// ValidateNetworkObject is a stub for validation logic
+// ValidateNetworkObject checks a network object locally before it is sent to
+// the API. Addresses are only checked by the API, see ValidateNetworkObjects.
 func ValidateNetworkObject(name string, value interface{}) error {
 	if name == "" {
 		return fmt.Errorf("name is required")
 	}
-	// Add more validation as needed
-	return nil
-}
 
-// GetNetworkObjectNames retrieves and returns all network object names using GetNetworkObjects().
-func GetNetworkObjectNames(client *APIClient) ([]string, error) {
-	data, err := GetNetworkObjects(client)
-	if err != nil {
-		return nil, err
-	}
-
-	var names []string
-	m, ok := data.(map[string]interface{})
+	v, ok := value.(NetworkObjectValue)
 	if !ok {
-		return nil, fmt.Errorf("unexpected response format: not a map")
+		return nil
 	}
+	switch v.Type {
+	case "host", "network", "range", "fqdn":
+	default:
+		return fmt.Errorf("type must be host, network, range or fqdn, got '%s'", v.Type)
+	}
+	if len(v.Addresses) == 0 {
+		return fmt.Errorf("at least one address is required")
+	}
+	return nil
+}
 
-	// Try to get multiple objects from "results" key
-	if results, ok := m["results"].([]interface{}); ok {
-		for _, obj := range results {
-			objMap, ok := obj.(map[string]interface{})
-			if !ok {
-				continue
-			}
-			if name, ok := objMap["name"].(string); ok {
-				names = append(names, name)
-			}
+// ValidateNetworkObjects has the API validate network objects without
+// creating them. Rejected objects are returned as an *ObjectValidationError.
+func ValidateNetworkObjects(ctx context.Context, client *APIClient, objects []NetworkObjectPayload) error {
+	return validateObjects(ctx, client, NetworkObjectsValidateEndpoint, objects, "network objects")
+}
+
+// UploadNetworkObjects creates network objects in a single request. The
+// addresses of an object are sent comma separated. The objects and the CSV
+// file are validated before the upload, see uploadObjects.
+func UploadNetworkObjects(ctx context.Context, client *APIClient, objects []NetworkObjectPayload) (*ObjectUploadResult, error) {
+	rows := make([][]string, 0, len(objects))
+	for _, object := range objects {
+		if err := ValidateNetworkObject(object.Name, object.Value); err != nil {
+			return nil, fmt.Errorf("validation failed for network object '%s': %w", object.Name, err)
 		}
-		return names, nil
+		value, _ := object.Value.(NetworkObjectValue)
+		rows = append(rows, []string{object.Name, object.Description, value.Type, strings.Join(value.Addresses, ",")})
 	}
 
-	// Fallback: handle single object (no "results" key, just a single object)
-	if name, ok := m["name"].(string); ok {
-		names = append(names, name)
-		return names, nil
+	endpoints := objectUploadEndpoints{Upload: NetworkObjectsUploadEndpoint, Validate: NetworkObjectsValidateEndpoint}
+	return uploadObjects(ctx, client, endpoints, objects, networkObjectsCSVHeader, rows, "network objects")
+}
+
+// GetNetworkObjectNames retrieves and returns all network object names using GetNetworkObjects().
+func GetNetworkObjectNames(ctx context.Context, client *APIClient) ([]string, error) {
+	objects, err := GetNetworkObjects(ctx, client)
+	if err != nil {
+		return nil, err
 	}
 
-	// Fallback: try to extract from a slice at the top level
-	if arr, ok := data.([]interface{}); ok {
-		for _, obj := range arr {
-			objMap, ok := obj.(map[string]interface{})
-			if !ok {
-				continue
-			}
-			if name, ok := objMap["name"].(string); ok {
-				names = append(names, name)
-			}
+	var names []string
+	for _, obj := range objects {
+		if name, ok := obj["name"].(string); ok {
+			names = append(names, name)
 		}
-		return names, nil
 	}
-
-	return nil, fmt.Errorf("could not extract network object names from response")
+	return names, nil
 }
 
 // =========================
 // Bulk Delete Utility
 // =========================
 // DeleteNetworkObjectByName deletes all network objects whose name starts with the given prefix.
-func DeleteNetworkObjectByName(client *APIClient, prefix string) error {
-	data, err := GetNetworkObjects(client)
+func DeleteNetworkObjectByName(ctx context.Context, client *APIClient, prefix string) error {
+	objects, err := GetNetworkObjects(ctx, client)
 	if err != nil {
 		return fmt.Errorf("failed to get network objects: %w", err)
 	}
 
-	m, ok := data.(map[string]interface{})
-	if !ok {
-		return fmt.Errorf("unexpected response format: not a map")
-	}
-
-	results, ok := m["results"].([]interface{})
-	if !ok {
-		return fmt.Errorf("unexpected response format: missing or invalid 'results' field")
-	}
-
 	var deletedCount int
-	for _, obj := range results {
-		objMap, ok := obj.(map[string]interface{})
-		if !ok {
-			continue
-		}
+	for _, objMap := range objects {
 		name, ok := objMap["name"].(string)
 		if !ok || len(name) < len(prefix) || name[:len(prefix)] != prefix {
 			continue
@@ -335,18 +290,17 @@ func DeleteNetworkObjectByName(client *APIClient, prefix string) error {
 			if idNum, ok2 := objMap["id"].(float64); ok2 {
 				id = fmt.Sprintf("%.0f", idNum)
 			} else {
-				fmt.Printf("[DeleteNetworkObjectByName] Could not get id for object with name %s\n", name)
+				logWarn(ctx, ScopePolicies, "Network object has no ID, skipping it", map[string]interface{}{"object_name": name})
 				continue
 			}
 		}
-		err := DeleteNetworkObject(client, id)
+		err := DeleteNetworkObject(ctx, client, id)
 		if err != nil {
-			fmt.Printf("[DeleteNetworkObjectByName] Failed to delete object ID %s (%s): %v\n", id, name, err)
+			logWarn(ctx, ScopePolicies, "Failed to delete network object", map[string]interface{}{"object_id": id, "object_name": name, "error": err.Error()})
 		} else {
-			fmt.Printf("[DeleteNetworkObjectByName] Deleted object ID %s (%s)\n", id, name)
 			deletedCount++
 		}
 	}
-	fmt.Printf("[DeleteNetworkObjectByName] Total deleted: %d\n", deletedCount)
+	logDebug(ctx, ScopePolicies, "Deleted network objects by name prefix", map[string]interface{}{"prefix": prefix, "count": deletedCount})
 	return nil
 }
@@ -4,52 +4,284 @@
 package apiclient
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
-	"io"
+	"iter"
+	"net/http"
+	"net/url"
+)
+
+// Network Tunnel Groups API endpoints
+const (
+	NetworkTunnelGroupsEndpoint       = "networktunnelgroups"
+	NetworkTunnelGroupDetailsEndpoint = "networktunnelgroups/%d"
+	NetworkTunnelGroupStateEndpoint   = "networktunnelgroups/%d/state"
+	NetworkTunnelGroupPeersEndpoint   = "networktunnelgroups/%d/peers"
+	NetworkTunnelGroupsStateEndpoint  = "networktunnelgroupsstate"
 )
 
 // NetworkTunnelGroup represents a network tunnel group
 type NetworkTunnelGroup struct {
-	ID             int64  `json:"id"`
-	Name           string `json:"name"`
-	OrganizationID int64  `json:"organizationId"`
-	DeviceType     string `json:"deviceType"`
-	Region         string `json:"region"`
-	Status         string `json:"status"`
-	CreatedAt      string `json:"createdAt"`
-	ModifiedAt     string `json:"modifiedAt"`
-}
-
-// NetworkTunnelGroupList represents the response from the network tunnel groups endpoint
-type NetworkTunnelGroupList struct {
-	Data   []NetworkTunnelGroup `json:"data"`
-	Offset int                  `json:"offset"`
-	Limit  int                  `json:"limit"`
-	Total  int                  `json:"total"`
-}
-
-// GetNetworkTunnelGroups retrieves a list of network tunnel groups
-func GetNetworkTunnelGroups(client *APIClient) ([]NetworkTunnelGroup, error) {
-	resp, err := client.Query("deployments", "networktunnelgroups", "GET", nil)
+	ID             int64                      `json:"id"`
+	Name           string                     `json:"name"`
+	OrganizationID int64                      `json:"organizationId"`
+	DeviceType     string                     `json:"deviceType"`
+	Region         string                     `json:"region"`
+	Status         string                     `json:"status"`
+	Hubs           []NetworkTunnelHub         `json:"hubs,omitempty"`
+	Routing        *NetworkTunnelGroupRouting `json:"routing,omitempty"`
+	CreatedAt      string                     `json:"createdAt"`
+	ModifiedAt     string                     `json:"modifiedAt"`
+}
+
+// NetworkTunnelHub is one of the primary or secondary hubs the tunnels of a
+// group terminate on. AuthID is the IKE ID the peer authenticates with.
+type NetworkTunnelHub struct {
+	ID         int64 `json:"id"`
+	IsPrimary  bool  `json:"isPrimary"`
+	Datacenter struct {
+		Name string `json:"name"`
+		IP   string `json:"ip,omitempty"`
+	} `json:"datacenter"`
+	AuthID       string                 `json:"authId"`
+	Status       NetworkTunnelHubStatus `json:"status"`
+	TunnelsCount int                    `json:"tunnelsCount"`
+}
+
+// NetworkTunnelHubStatus is the UP or DOWN status of a hub and the time it was recorded
+type NetworkTunnelHubStatus struct {
+	Status string `json:"status"`
+	Time   string `json:"time"`
+}
+
+// NetworkTunnelGroupState is the tunnel state of a network tunnel group
+type NetworkTunnelGroupState struct {
+	ID             int64                   `json:"id"`
+	Name           string                  `json:"name"`
+	OrganizationID int64                   `json:"organizationId"`
+	Status         string                  `json:"status"`
+	Hubs           []NetworkTunnelHubState `json:"hubs"`
+}
+
+// NetworkTunnelHubState is the state of a hub and of the tunnels its peers established
+type NetworkTunnelHubState struct {
+	ID         int64 `json:"id"`
+	IsPrimary  bool  `json:"isPrimary"`
+	Datacenter struct {
+		Name string `json:"name"`
+	} `json:"datacenter"`
+	Status        NetworkTunnelHubStatus `json:"status"`
+	TunnelsStatus []NetworkTunnelState   `json:"tunnelsStatus"`
+}
+
+// NetworkTunnelState is the state of a single tunnel between a peer and a hub
+type NetworkTunnelState struct {
+	Time       string `json:"time"`
+	Status     string `json:"status"`
+	DC         string `json:"dc"`
+	DCName     string `json:"dcName"`
+	DCDesc     string `json:"dcDesc"`
+	IKEState   string `json:"ikeState"`
+	IPSecState string `json:"ipsecState"`
+	PeerID     string `json:"peerId"`
+	PeerIP     string `json:"peerIp"`
+	PeerPort   string `json:"peerPort"`
+	LocalIP    string `json:"localIp"`
+	LocalPort  string `json:"localPort"`
+}
+
+// NetworkTunnelGroupRouting is the routing of a network tunnel group
+type NetworkTunnelGroupRouting struct {
+	Type string                        `json:"type"`
+	Data NetworkTunnelGroupRoutingData `json:"data"`
+}
+
+// NetworkTunnelGroupRoutingData holds the network CIDRs of static routing or
+// the AS number and neighbor settings of BGP routing.
+type NetworkTunnelGroupRoutingData struct {
+	NetworkCIDRs     []string `json:"networkCIDRs,omitempty"`
+	ASNumber         string   `json:"asNumber,omitempty"`
+	BGPHopCount      int      `json:"bgpHopCount,omitempty"`
+	BGPNeighborCIDRs []string `json:"bgpNeighborCIDRs,omitempty"`
+	BGPServerSubnets []string `json:"bgpServerSubnets,omitempty"`
+}
+
+// UnmarshalJSON accepts the empty string the API returns as data for nat routing.
+func (d *NetworkTunnelGroupRoutingData) UnmarshalJSON(b []byte) error {
+	if len(b) == 0 || b[0] != '{' {
+		*d = NetworkTunnelGroupRoutingData{}
+		return nil
+	}
+
+	type plain NetworkTunnelGroupRoutingData
+	return json.Unmarshal(b, (*plain)(d))
+}
+
+// NetworkTunnelGroupPayload is the request body to create a network tunnel group
+type NetworkTunnelGroupPayload struct {
+	Name         string                     `json:"name"`
+	Region       string                     `json:"region"`
+	DeviceType   string                     `json:"deviceType,omitempty"`
+	AuthIDPrefix string                     `json:"authIdPrefix"`
+	Passphrase   string                     `json:"passphrase"`
+	Routing      *NetworkTunnelGroupRouting `json:"routing,omitempty"`
+}
+
+// NetworkTunnelGroupPatch is a single replace operation of a network tunnel
+// group update. Path is one of /name, /authIdPrefix, /passphrase, /region or /routing.
+type NetworkTunnelGroupPatch struct {
+	Op    string      `json:"op"`
+	Path  string      `json:"path"`
+	Value interface{} `json:"value"`
+}
+
+// GetNetworkTunnelGroups retrieves all network tunnel groups
+func GetNetworkTunnelGroups(ctx context.Context, client *APIClient) ([]NetworkTunnelGroup, error) {
+	return CollectAll(networkTunnelGroups(ctx, client, ""))
+}
+
+// networkTunnelGroups iterates over the network tunnel groups, optionally
+// filtered by exact name on the server.
+func networkTunnelGroups(ctx context.Context, client *APIClient, name string) iter.Seq2[NetworkTunnelGroup, error] {
+	endpoint := NetworkTunnelGroupsEndpoint
+	if name != "" {
+		filters, _ := json.Marshal(map[string]string{"exactName": name})
+		endpoint += "?filters=" + url.QueryEscape(string(filters))
+	}
+	return Paginate[NetworkTunnelGroup](ctx, client, ListRequest{
+		Scope:    ScopeDeployments,
+		Endpoint: endpoint,
+		Style:    PageOffset,
+		Action:   "get network tunnel groups",
+	})
+}
+
+// GetNetworkTunnelGroupIDByName returns the ID of the network tunnel group with the given name
+func GetNetworkTunnelGroupIDByName(ctx context.Context, client *APIClient, name string) (int64, error) {
+	group, found, err := FindFirst(networkTunnelGroups(ctx, client, name), func(g NetworkTunnelGroup) bool { return g.Name == name })
+	if err != nil {
+		return 0, err
+	}
+	if !found {
+		return 0, fmt.Errorf("network tunnel group with name '%s' not found", name)
+	}
+
+	return group.ID, nil
+}
+
+// GetNetworkTunnelGroup retrieves a network tunnel group by ID
+func GetNetworkTunnelGroup(ctx context.Context, client *APIClient, id int64) (*NetworkTunnelGroup, error) {
+	resp, err := client.Query(ctx, ScopeDeployments, fmt.Sprintf(NetworkTunnelGroupDetailsEndpoint, id), OperationGet, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get network tunnel group: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, NewAPIError(resp, fmt.Sprintf("get network tunnel group %d", id))
+	}
+
+	var group NetworkTunnelGroup
+	if err := json.NewDecoder(resp.Body).Decode(&group); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return &group, nil
+}
+
+// CreateNetworkTunnelGroup creates a new network tunnel group
+func CreateNetworkTunnelGroup(ctx context.Context, client *APIClient, payload NetworkTunnelGroupPayload) (*NetworkTunnelGroup, error) {
+	resp, err := client.Query(ctx, ScopeDeployments, NetworkTunnelGroupsEndpoint, OperationPost, payload)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create network tunnel group: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusCreated {
+		return nil, NewAPIError(resp, "create network tunnel group")
+	}
+
+	var group NetworkTunnelGroup
+	if err := json.NewDecoder(resp.Body).Decode(&group); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	logDebug(ctx, ScopeDeployments, "Created network tunnel group", map[string]interface{}{"network_tunnel_group_id": group.ID, "name": group.Name})
+	return &group, nil
+}
+
+// UpdateNetworkTunnelGroup applies replace operations to a network tunnel group
+func UpdateNetworkTunnelGroup(ctx context.Context, client *APIClient, id int64, patches []NetworkTunnelGroupPatch) (*NetworkTunnelGroup, error) {
+	resp, err := client.Query(ctx, ScopeDeployments, fmt.Sprintf(NetworkTunnelGroupDetailsEndpoint, id), OperationPatch, patches)
 	if err != nil {
-		return nil, err
+		return nil, fmt.Errorf("failed to update network tunnel group: %w", err)
 	}
 	defer resp.Body.Close()
 
-	body, err := io.ReadAll(resp.Body)
+	if resp.StatusCode != http.StatusOK {
+		return nil, NewAPIError(resp, fmt.Sprintf("update network tunnel group %d", id))
+	}
+
+	var group NetworkTunnelGroup
+	if err := json.NewDecoder(resp.Body).Decode(&group); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return &group, nil
+}
+
+// DeleteNetworkTunnelGroup deletes a network tunnel group
+func DeleteNetworkTunnelGroup(ctx context.Context, client *APIClient, id int64) error {
+	resp, err := client.Query(ctx, ScopeDeployments, fmt.Sprintf(NetworkTunnelGroupDetailsEndpoint, id), OperationDelete, nil)
 	if err != nil {
-		return nil, err
+		return fmt.Errorf("failed to delete network tunnel group: %w", err)
 	}
+	defer resp.Body.Close()
 
-	if resp.StatusCode != 200 {
-		return nil, fmt.Errorf("failed to get network tunnel groups. Status: %s, Body: %s", resp.Status, string(body))
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusNoContent {
+		return NewAPIError(resp, fmt.Sprintf("delete network tunnel group %d", id))
 	}
 
-	var list NetworkTunnelGroupList
-	if err := json.Unmarshal(body, &list); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
+	return nil
+}
+
+// GetNetworkTunnelGroupState retrieves the hub and tunnel state of a network tunnel group
+func GetNetworkTunnelGroupState(ctx context.Context, client *APIClient, id int64) (*NetworkTunnelGroupState, error) {
+	resp, err := client.Query(ctx, ScopeDeployments, fmt.Sprintf(NetworkTunnelGroupStateEndpoint, id), OperationGet, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get network tunnel group state: %w", err)
 	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, NewAPIError(resp, fmt.Sprintf("get state of network tunnel group %d", id))
+	}
+
+	var state NetworkTunnelGroupState
+	if err := json.NewDecoder(resp.Body).Decode(&state); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return &state, nil
+}
+
+// GetNetworkTunnelGroupPeers retrieves the state of every tunnel of a network tunnel group
+func GetNetworkTunnelGroupPeers(ctx context.Context, client *APIClient, id int64) ([]NetworkTunnelState, error) {
+	return CollectAll(Paginate[NetworkTunnelState](ctx, client, ListRequest{
+		Scope:    ScopeDeployments,
+		Endpoint: fmt.Sprintf(NetworkTunnelGroupPeersEndpoint, id),
+		Style:    PageOffset,
+		Action:   fmt.Sprintf("get peers of network tunnel group %d", id),
+	}))
+}
 
-	return list.Data, nil
+// GetNetworkTunnelGroupsState retrieves the state of all network tunnel groups
+func GetNetworkTunnelGroupsState(ctx context.Context, client *APIClient) ([]NetworkTunnelGroupState, error) {
+	return CollectAll(Paginate[NetworkTunnelGroupState](ctx, client, ListRequest{
+		Scope:    ScopeDeployments,
+		Endpoint: NetworkTunnelGroupsStateEndpoint,
+		Style:    PageOffset,
+		Action:   "get state of network tunnel groups",
+	}))
 }
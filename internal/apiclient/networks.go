@@ -0,0 +1,144 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package apiclient
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"iter"
+	"net/http"
+)
+
+// Networks API endpoints
+const (
+	NetworksEndpoint       = "networks"
+	NetworkDetailsEndpoint = "networks/%d"
+)
+
+// Network represents a registered egress network. Its origin ID is the
+// identity ID used in access rule source conditions.
+type Network struct {
+	OriginID     int64  `json:"originId"`
+	Name         string `json:"name"`
+	IPAddress    string `json:"ipAddress"`
+	PrefixLength int    `json:"prefixLength"`
+	IsDynamic    bool   `json:"isDynamic"`
+	IsVerified   bool   `json:"isVerified"`
+	Status       string `json:"status"`
+	CreatedAt    string `json:"createdAt,omitempty"`
+}
+
+// NetworkPayload is the request body to create or update a network
+type NetworkPayload struct {
+	Name         string `json:"name"`
+	IPAddress    string `json:"ipAddress,omitempty"`
+	PrefixLength int    `json:"prefixLength"`
+	IsDynamic    bool   `json:"isDynamic"`
+	Status       string `json:"status"`
+}
+
+// GetNetworks retrieves all networks
+func GetNetworks(ctx context.Context, client *APIClient) ([]Network, error) {
+	return CollectAll(networks(ctx, client))
+}
+
+// networks iterates over the networks of the organization.
+func networks(ctx context.Context, client *APIClient) iter.Seq2[Network, error] {
+	return Paginate[Network](ctx, client, ListRequest{
+		Scope:    ScopeDeployments,
+		Endpoint: NetworksEndpoint,
+		Style:    PageNumber,
+		Action:   "get networks",
+	})
+}
+
+// GetNetworkIDByName returns the origin ID of the network with the given name
+func GetNetworkIDByName(ctx context.Context, client *APIClient, name string) (int64, error) {
+	network, found, err := FindFirst(networks(ctx, client), func(n Network) bool { return n.Name == name })
+	if err != nil {
+		return 0, err
+	}
+	if !found {
+		return 0, fmt.Errorf("network with name '%s' not found", name)
+	}
+
+	return network.OriginID, nil
+}
+
+// GetNetwork retrieves a network by origin ID
+func GetNetwork(ctx context.Context, client *APIClient, id int64) (*Network, error) {
+	resp, err := client.Query(ctx, ScopeDeployments, fmt.Sprintf(NetworkDetailsEndpoint, id), OperationGet, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get network: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, NewAPIError(resp, fmt.Sprintf("get network %d", id))
+	}
+
+	var network Network
+	if err := json.NewDecoder(resp.Body).Decode(&network); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return &network, nil
+}
+
+// CreateNetwork creates a new network
+func CreateNetwork(ctx context.Context, client *APIClient, payload NetworkPayload) (*Network, error) {
+	resp, err := client.Query(ctx, ScopeDeployments, NetworksEndpoint, OperationPost, payload)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create network: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusCreated {
+		return nil, NewAPIError(resp, "create network")
+	}
+
+	var network Network
+	if err := json.NewDecoder(resp.Body).Decode(&network); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	logDebug(ctx, ScopeDeployments, "Created network", map[string]interface{}{"origin_id": network.OriginID, "name": network.Name})
+	return &network, nil
+}
+
+// UpdateNetwork updates an existing network
+func UpdateNetwork(ctx context.Context, client *APIClient, id int64, payload NetworkPayload) (*Network, error) {
+	resp, err := client.Query(ctx, ScopeDeployments, fmt.Sprintf(NetworkDetailsEndpoint, id), OperationPut, payload)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update network: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, NewAPIError(resp, fmt.Sprintf("update network %d", id))
+	}
+
+	var network Network
+	if err := json.NewDecoder(resp.Body).Decode(&network); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return &network, nil
+}
+
+// DeleteNetwork deletes a network
+func DeleteNetwork(ctx context.Context, client *APIClient, id int64) error {
+	resp, err := client.Query(ctx, ScopeDeployments, fmt.Sprintf(NetworkDetailsEndpoint, id), OperationDelete, nil)
+	if err != nil {
+		return fmt.Errorf("failed to delete network: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusNoContent {
+		return NewAPIError(resp, fmt.Sprintf("delete network %d", id))
+	}
+
+	return nil
+}
@@ -0,0 +1,165 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package apiclient
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"mime/multipart"
+	"net/http"
+	"strings"
+)
+
+// ErrUnsupportedCSVLayout is returned by the uploads when the API rejects the
+// generated CSV file although it accepts the same objects as JSON, which means
+// that it does not understand the columns of the file.
+var ErrUnsupportedCSVLayout = errors.New("unsupported CSV layout")
+
+// ObjectUploadResult is the response of the network and service object upload endpoints
+type ObjectUploadResult struct {
+	Message   string  `json:"message"`
+	Count     int     `json:"count"`
+	ObjectIDs []int64 `json:"objectIds"`
+}
+
+// ObjectFieldError is a single validation error of an object
+type ObjectFieldError struct {
+	Code   string `json:"code"`
+	Field  string `json:"field"`
+	Reason string `json:"reason"`
+}
+
+// ObjectRowErrors are the validation errors of one object. The specification
+// only calls Row "the row in the CSV file", and its example counts the header
+// line. How rows of JSON requests are numbered is not specified, so Row does
+// not reliably identify one of several objects sent together.
+type ObjectRowErrors struct {
+	Row    int                `json:"row"`
+	Errors []ObjectFieldError `json:"errors"`
+}
+
+// ObjectValidationError is returned when the validate endpoints reject one or
+// more objects.
+type ObjectValidationError struct {
+	Message string            `json:"message"`
+	Rows    []ObjectRowErrors `json:"errors"`
+}
+
+// Error implements error.
+func (e *ObjectValidationError) Error() string {
+	var reasons []string
+	for _, row := range e.Rows {
+		for _, fieldErr := range row.Errors {
+			reasons = append(reasons, fmt.Sprintf("row %d: %s: %s", row.Row, fieldErr.Field, fieldErr.Reason))
+		}
+	}
+	if len(reasons) == 0 {
+		return e.Message
+	}
+	return e.Message + " " + strings.Join(reasons, "; ")
+}
+
+// validateObjects sends objects to a validate endpoint. Objects the API
+// rejects are returned as an *ObjectValidationError.
+func validateObjects(ctx context.Context, client *APIClient, endpoint string, objects interface{}, kind string) error {
+	resp, err := client.Query(ctx, ScopePolicies, endpoint, OperationPost, objects)
+	if err != nil {
+		return fmt.Errorf("failed to validate %s: %w", kind, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusBadRequest {
+		var validationErr ObjectValidationError
+		if err := json.NewDecoder(resp.Body).Decode(&validationErr); err == nil && len(validationErr.Rows) > 0 {
+			return &validationErr
+		}
+		return NewAPIError(resp, "validate "+kind)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return NewAPIError(resp, "validate "+kind)
+	}
+
+	return nil
+}
+
+// objectUploadEndpoints are the endpoints uploadObjects sends a CSV file to.
+type objectUploadEndpoints struct {
+	Upload   string
+	Validate string
+}
+
+// uploadObjects creates objects from a CSV file with the given header and
+// rows through an upload endpoint. The API specification does not define the
+// columns of the file, so before the upload the file is checked with the
+// validate endpoint, which accepts the same multipart form. objects are the
+// same objects as sent in JSON; they are validated first, so that a rejected
+// file can only be caused by its layout.
+func uploadObjects(ctx context.Context, client *APIClient, endpoints objectUploadEndpoints, objects interface{}, header []string, rows [][]string, kind string) (*ObjectUploadResult, error) {
+	body, err := csvUploadBody(header, rows)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build %s upload: %w", kind, err)
+	}
+
+	if err := validateObjects(ctx, client, endpoints.Validate, objects, kind); err != nil {
+		return nil, err
+	}
+	if err := validateObjects(ctx, client, endpoints.Validate, body, kind); err != nil {
+		var validationErr *ObjectValidationError
+		var apiErr *APIError
+		if errors.As(err, &validationErr) || (errors.As(err, &apiErr) &&
+			(apiErr.StatusCode == http.StatusBadRequest || apiErr.StatusCode == http.StatusUnsupportedMediaType || apiErr.StatusCode == http.StatusUnprocessableEntity)) {
+			return nil, fmt.Errorf("%w: the API accepts the %s but rejects them as a CSV file with the columns %s: %s",
+				ErrUnsupportedCSVLayout, kind, strings.Join(header, ", "), err)
+		}
+		return nil, err
+	}
+
+	resp, err := client.Query(ctx, ScopePolicies, endpoints.Upload, OperationPost, body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to upload %s: %w", kind, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusCreated {
+		return nil, NewAPIError(resp, "upload "+kind)
+	}
+
+	var result ObjectUploadResult
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	logDebug(ctx, ScopePolicies, "Uploaded "+kind, map[string]interface{}{"count": result.Count})
+	return &result, nil
+}
+
+// csvUploadBody encodes header and rows as a CSV file in a multipart form, as
+// expected by the upload endpoints.
+func csvUploadBody(header []string, rows [][]string) (RawBody, error) {
+	var buf bytes.Buffer
+	form := multipart.NewWriter(&buf)
+
+	file, err := form.CreateFormFile("file", "objects.csv")
+	if err != nil {
+		return RawBody{}, err
+	}
+
+	w := csv.NewWriter(file)
+	if err := w.Write(header); err != nil {
+		return RawBody{}, err
+	}
+	if err := w.WriteAll(rows); err != nil {
+		return RawBody{}, err
+	}
+
+	if err := form.Close(); err != nil {
+		return RawBody{}, err
+	}
+
+	return RawBody{ContentType: form.FormDataContentType(), Data: buf.Bytes()}, nil
+}
@@ -0,0 +1,121 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package apiclient
+
+import (
+	"context"
+	"encoding/csv"
+	"errors"
+	"io"
+	"net/http"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestUploadNetworkObjects(t *testing.T) {
+	objects := []NetworkObjectPayload{
+		{Name: "web", Description: "Web servers", Value: NetworkObjectValue{Type: "host", Addresses: []string{"10.0.0.1", "10.0.0.2"}}},
+	}
+	rejected := `{"message":"Validation failed.","errors":[{"row":2,"errors":[{"code":"invalid","field":"value","reason":"unknown column"}]}]}`
+
+	tests := map[string]struct {
+		jsonStatus, csvStatus int
+		wantErr               error
+		wantValidationErr     bool
+		wantUpload            bool
+	}{
+		"valid": {
+			jsonStatus: http.StatusOK,
+			csvStatus:  http.StatusOK,
+			wantUpload: true,
+		},
+		"invalid objects": {
+			jsonStatus:        http.StatusBadRequest,
+			csvStatus:         http.StatusOK,
+			wantValidationErr: true,
+		},
+		"rejected CSV": {
+			jsonStatus: http.StatusOK,
+			csvStatus:  http.StatusBadRequest,
+			wantErr:    ErrUnsupportedCSVLayout,
+		},
+		"CSV not accepted": {
+			jsonStatus: http.StatusOK,
+			csvStatus:  http.StatusUnsupportedMediaType,
+			wantErr:    ErrUnsupportedCSVLayout,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			var uploaded bool
+			client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+				switch {
+				case strings.HasSuffix(r.URL.Path, "/"+NetworkObjectsUploadEndpoint):
+					uploaded = true
+					io.WriteString(w, `{"message":"Uploaded","count":1,"objectIds":[42]}`)
+				case strings.HasSuffix(r.URL.Path, "/"+NetworkObjectsValidateEndpoint):
+					status := test.jsonStatus
+					if strings.HasPrefix(r.Header.Get("Content-Type"), "multipart/form-data") {
+						status = test.csvStatus
+						checkUploadedCSV(t, r)
+					}
+					w.WriteHeader(status)
+					if status == http.StatusBadRequest {
+						io.WriteString(w, rejected)
+					}
+				default:
+					t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+					w.WriteHeader(http.StatusNotFound)
+				}
+			})
+
+			result, err := UploadNetworkObjects(context.Background(), client, objects)
+
+			var validationErr *ObjectValidationError
+			switch {
+			case test.wantErr != nil:
+				if !errors.Is(err, test.wantErr) {
+					t.Errorf("error = %v, want %v", err, test.wantErr)
+				}
+			case test.wantValidationErr:
+				if !errors.As(err, &validationErr) || errors.Is(err, ErrUnsupportedCSVLayout) {
+					t.Errorf("error = %v, want an *ObjectValidationError", err)
+				}
+			case err != nil:
+				t.Fatalf("UploadNetworkObjects() error = %s", err)
+			default:
+				if !slices.Equal(result.ObjectIDs, []int64{42}) {
+					t.Errorf("object IDs = %v, want [42]", result.ObjectIDs)
+				}
+			}
+			if uploaded != test.wantUpload {
+				t.Errorf("uploaded = %t, want %t", uploaded, test.wantUpload)
+			}
+		})
+	}
+}
+
+// checkUploadedCSV checks the CSV file of a multipart upload request.
+func checkUploadedCSV(t *testing.T, r *http.Request) {
+	t.Helper()
+
+	file, _, err := r.FormFile("file")
+	if err != nil {
+		t.Errorf("reading the CSV file: %s", err)
+		return
+	}
+	defer file.Close()
+
+	records, err := csv.NewReader(file).ReadAll()
+	if err != nil {
+		t.Errorf("parsing the CSV file: %s", err)
+		return
+	}
+	want := [][]string{networkObjectsCSVHeader, {"web", "Web servers", "host", "10.0.0.1,10.0.0.2"}}
+	if !slices.EqualFunc(records, want, slices.Equal[[]string]) {
+		t.Errorf("CSV records = %q, want %q", records, want)
+	}
+}
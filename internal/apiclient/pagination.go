@@ -0,0 +1,276 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package apiclient
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"iter"
+	"net/http"
+	"sort"
+	"strings"
+)
+
+// DefaultPageSize is the number of items requested per page. It is the
+// maximum most list endpoints accept.
+const DefaultPageSize = 100
+
+// PageStyle selects the query parameters an endpoint uses for paging.
+type PageStyle int
+
+const (
+	// PageNumber requests pages with 1-based "page" and "limit" parameters.
+	PageNumber PageStyle = iota
+	// PageOffset requests pages with "offset" and "limit" parameters.
+	PageOffset
+	// PageNone fetches the collection with a single request.
+	PageNone
+)
+
+// ListRequest describes a collection endpoint for Paginate.
+type ListRequest struct {
+	// Scope and Endpoint are passed to Query. Endpoint may already carry
+	// query parameters.
+	Scope    string
+	Endpoint string
+	Style    PageStyle
+	// PageSize defaults to DefaultPageSize.
+	PageSize int
+	// ItemsPath locates the items in responses that nest them below the
+	// envelope, e.g. {"data", "applications"}.
+	ItemsPath []string
+	// Action describes the request in errors, e.g. "get service objects".
+	Action string
+}
+
+// pageEnvelopeKeys are the response fields that hold the items of a page, in
+// the order they are looked up.
+var pageEnvelopeKeys = []string{"data", "items", "results", "result"}
+
+// Paginate iterates over all items of a collection, requesting pages until
+// the collection is exhausted or the caller stops the iteration. Pages may be
+// bare arrays, objects wrapping the items in one of pageEnvelopeKeys, or maps
+// of items keyed by ID. An error is yielded once and ends the iteration.
+func Paginate[T any](ctx context.Context, client *APIClient, req ListRequest) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		var zero T
+
+		pageSize := req.PageSize
+		if pageSize <= 0 {
+			pageSize = DefaultPageSize
+		}
+
+		page, offset, seen := 1, 0, 0
+		var previous []byte
+		for {
+			endpoint := req.Endpoint
+			if req.Style != PageNone {
+				sep := "?"
+				if strings.Contains(endpoint, "?") {
+					sep = "&"
+				}
+				if req.Style == PageNumber {
+					endpoint = fmt.Sprintf("%s%spage=%d&limit=%d", endpoint, sep, page, pageSize)
+				} else {
+					endpoint = fmt.Sprintf("%s%soffset=%d&limit=%d", endpoint, sep, offset, pageSize)
+				}
+			}
+
+			body, err := fetchPage(ctx, client, req, endpoint)
+			if err != nil {
+				yield(zero, err)
+				return
+			}
+
+			// Stop if the server ignores the paging parameters and keeps
+			// returning the same page.
+			if previous != nil && bytes.Equal(body, previous) {
+				return
+			}
+			previous = body
+
+			items, total, err := decodePage[T](body, req.ItemsPath)
+			if err != nil {
+				yield(zero, fmt.Errorf("failed to decode %s response: %w", req.Endpoint, err))
+				return
+			}
+
+			logDebug(ctx, req.Scope, "Retrieved page", map[string]interface{}{
+				"endpoint": req.Endpoint,
+				"page":     page,
+				"offset":   offset,
+				"count":    len(items),
+				"total":    total,
+			})
+
+			for _, item := range items {
+				if !yield(item, nil) {
+					return
+				}
+			}
+
+			seen += len(items)
+			switch {
+			case req.Style == PageNone, len(items) == 0:
+				return
+			case total > 0:
+				if seen >= total {
+					return
+				}
+			case len(items) > pageSize:
+				// The endpoint returned everything at once.
+				return
+			}
+			// A short page is not necessarily the last one: servers may cap
+			// the limit below the requested page size. Without a total the
+			// collection ends with an empty page.
+
+			page++
+			offset += len(items)
+		}
+	}
+}
+
+// fetchPage requests one page and returns its body.
+func fetchPage(ctx context.Context, client *APIClient, req ListRequest, endpoint string) ([]byte, error) {
+	resp, err := client.Query(ctx, req.Scope, endpoint, OperationGet, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to %s: %w", req.Action, err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response body: %w", err)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, newAPIErrorWithBody(resp, body, req.Action)
+	}
+
+	return body, nil
+}
+
+// decodePage returns the items of a page and the size of the whole
+// collection, or 0 if the response does not report it.
+func decodePage[T any](body []byte, itemsPath []string) ([]T, int, error) {
+	body = bytes.TrimSpace(body)
+	if len(body) == 0 || bytes.Equal(body, []byte("null")) {
+		return nil, 0, nil
+	}
+
+	if body[0] == '[' {
+		var items []T
+		if err := json.Unmarshal(body, &items); err != nil {
+			return nil, 0, err
+		}
+		return items, 0, nil
+	}
+
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(body, &fields); err != nil {
+		return nil, 0, err
+	}
+
+	if len(itemsPath) > 0 {
+		raw := json.RawMessage(body)
+		for _, key := range itemsPath {
+			var nested map[string]json.RawMessage
+			if err := json.Unmarshal(raw, &nested); err != nil {
+				return nil, 0, fmt.Errorf("failed to read %q: %w", key, err)
+			}
+			raw = nested[key]
+		}
+		var items []T
+		if len(raw) > 0 {
+			if err := json.Unmarshal(raw, &items); err != nil {
+				return nil, 0, err
+			}
+		}
+		return items, pageTotal(fields), nil
+	}
+
+	for _, key := range pageEnvelopeKeys {
+		raw, ok := fields[key]
+		if !ok {
+			continue
+		}
+		var items []T
+		if err := json.Unmarshal(raw, &items); err != nil {
+			return nil, 0, fmt.Errorf("failed to read %q: %w", key, err)
+		}
+		return items, pageTotal(fields), nil
+	}
+
+	// Some category endpoints return a map of items keyed by ID. Any other
+	// object without items is an empty page.
+	for key, raw := range fields {
+		if raw = bytes.TrimSpace(raw); key == "meta" || key == "status" || len(raw) == 0 || raw[0] != '{' {
+			return nil, pageTotal(fields), nil
+		}
+	}
+	keys := make([]string, 0, len(fields))
+	for key := range fields {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	items := make([]T, 0, len(keys))
+	for _, key := range keys {
+		var item T
+		if err := json.Unmarshal(fields[key], &item); err != nil {
+			return nil, 0, fmt.Errorf("failed to read %q: %w", key, err)
+		}
+		items = append(items, item)
+	}
+	return items, 0, nil
+}
+
+// pageTotal returns the collection size reported by a page envelope as
+// "total" or "meta.total". "count" is not used: depending on the endpoint it
+// is the size of the collection or of the page.
+func pageTotal(fields map[string]json.RawMessage) int {
+	var total int
+	if raw, ok := fields["total"]; ok && json.Unmarshal(raw, &total) == nil {
+		return total
+	}
+	if raw, ok := fields["meta"]; ok {
+		var meta struct {
+			Total int `json:"total"`
+		}
+		if json.Unmarshal(raw, &meta) == nil {
+			return meta.Total
+		}
+	}
+	return 0
+}
+
+// CollectAll drains seq into a slice, stopping at the first error.
+func CollectAll[T any](seq iter.Seq2[T, error]) ([]T, error) {
+	var items []T
+	for item, err := range seq {
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, item)
+	}
+	return items, nil
+}
+
+// FindFirst returns the first item of seq that matches, without requesting
+// the pages after it.
+func FindFirst[T any](seq iter.Seq2[T, error], match func(T) bool) (T, bool, error) {
+	var zero T
+	for item, err := range seq {
+		if err != nil {
+			return zero, false, err
+		}
+		if match(item) {
+			return item, true, nil
+		}
+	}
+	return zero, false, nil
+}
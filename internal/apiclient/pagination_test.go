@@ -0,0 +1,237 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package apiclient
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"testing"
+)
+
+type testItem struct {
+	ID int `json:"id"`
+}
+
+// pageOf returns the JSON array of the items with IDs from first to last.
+func pageOf(first, last int) string {
+	var items []string
+	for id := first; id <= last; id++ {
+		items = append(items, fmt.Sprintf(`{"id":%d}`, id))
+	}
+	return "[" + strings.Join(items, ",") + "]"
+}
+
+func itemIDs(items []testItem) []int {
+	ids := make([]int, 0, len(items))
+	for _, item := range items {
+		ids = append(ids, item.ID)
+	}
+	return ids
+}
+
+func TestPaginate(t *testing.T) {
+	tests := map[string]struct {
+		req          ListRequest
+		pages        func(r *http.Request) string
+		wantIDs      []int
+		wantRequests int32
+	}{
+		"stops at an empty page": {
+			req: ListRequest{Style: PageNumber, PageSize: 2},
+			pages: func(r *http.Request) string {
+				switch r.URL.Query().Get("page") {
+				case "1":
+					return pageOf(1, 2)
+				case "2":
+					return pageOf(3, 3)
+				}
+				return `[]`
+			},
+			wantIDs:      []int{1, 2, 3},
+			wantRequests: 3,
+		},
+		"stops at the reported total": {
+			req: ListRequest{Style: PageOffset, PageSize: 2},
+			pages: func(r *http.Request) string {
+				offset, _ := strconv.Atoi(r.URL.Query().Get("offset"))
+				return fmt.Sprintf(`{"data":%s,"total":3}`, pageOf(offset+1, min(offset+2, 3)))
+			},
+			wantIDs:      []int{1, 2, 3},
+			wantRequests: 2,
+		},
+		"stops at the total in meta": {
+			req: ListRequest{Style: PageNumber, PageSize: 2},
+			pages: func(r *http.Request) string {
+				if r.URL.Query().Get("page") == "1" {
+					return fmt.Sprintf(`{"results":%s,"meta":{"total":2}}`, pageOf(1, 2))
+				}
+				return `{"results":[],"meta":{"total":2}}`
+			},
+			wantIDs:      []int{1, 2},
+			wantRequests: 1,
+		},
+		"stops when the page repeats": {
+			req: ListRequest{Style: PageNumber, PageSize: 2},
+			pages: func(r *http.Request) string {
+				return pageOf(1, 2)
+			},
+			wantIDs:      []int{1, 2},
+			wantRequests: 2,
+		},
+		"stops when everything is returned at once": {
+			req: ListRequest{Style: PageNumber, PageSize: 2},
+			pages: func(r *http.Request) string {
+				return pageOf(1, 5)
+			},
+			wantIDs:      []int{1, 2, 3, 4, 5},
+			wantRequests: 1,
+		},
+		"single request without paging": {
+			req: ListRequest{Style: PageNone},
+			pages: func(r *http.Request) string {
+				if r.URL.Query().Has("page") || r.URL.Query().Has("limit") {
+					return `{"unexpected":"paging parameters"}`
+				}
+				return pageOf(1, 2)
+			},
+			wantIDs:      []int{1, 2},
+			wantRequests: 1,
+		},
+		"items below a path": {
+			req: ListRequest{Style: PageNumber, PageSize: 2, ItemsPath: []string{"data", "applications"}},
+			pages: func(r *http.Request) string {
+				if r.URL.Query().Get("page") == "1" {
+					return fmt.Sprintf(`{"data":{"applications":%s}}`, pageOf(1, 1))
+				}
+				return `{"data":{"applications":[]}}`
+			},
+			wantIDs:      []int{1},
+			wantRequests: 2,
+		},
+		"items keyed by ID": {
+			req: ListRequest{Style: PageNone},
+			pages: func(r *http.Request) string {
+				return `{"2":{"id":2},"1":{"id":1}}`
+			},
+			wantIDs:      []int{1, 2},
+			wantRequests: 1,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			var requests atomic.Int32
+			client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+				requests.Add(1)
+				io.WriteString(w, test.pages(r))
+			})
+
+			req := test.req
+			req.Scope = ScopePolicies
+			req.Endpoint = "items"
+			req.Action = "get items"
+
+			items, err := CollectAll(Paginate[testItem](context.Background(), client, req))
+			if err != nil {
+				t.Fatalf("CollectAll() error = %s", err)
+			}
+			if got := itemIDs(items); !slices.Equal(got, test.wantIDs) {
+				t.Errorf("items = %v, want %v", got, test.wantIDs)
+			}
+			if got := requests.Load(); got != test.wantRequests {
+				t.Errorf("requests = %d, want %d", got, test.wantRequests)
+			}
+		})
+	}
+}
+
+func TestPaginateKeepsEndpointQuery(t *testing.T) {
+	var query string
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		query = r.URL.RawQuery
+		io.WriteString(w, `[]`)
+	})
+
+	_, err := CollectAll(Paginate[testItem](context.Background(), client, ListRequest{
+		Scope:    ScopePolicies,
+		Endpoint: "items?type=network",
+		Style:    PageOffset,
+		Action:   "get items",
+	}))
+	if err != nil {
+		t.Fatalf("CollectAll() error = %s", err)
+	}
+	if want := "type=network&offset=0&limit=100"; query != want {
+		t.Errorf("query = %q, want %q", query, want)
+	}
+}
+
+func TestPaginateStopsWithTheCaller(t *testing.T) {
+	var requests atomic.Int32
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+		requests.Add(1)
+		io.WriteString(w, pageOf(page*2-1, page*2))
+	})
+
+	item, found, err := FindFirst(Paginate[testItem](context.Background(), client, ListRequest{
+		Scope:    ScopePolicies,
+		Endpoint: "items",
+		PageSize: 2,
+		Action:   "get items",
+	}), func(item testItem) bool { return item.ID == 3 })
+	if err != nil {
+		t.Fatalf("FindFirst() error = %s", err)
+	}
+	if !found || item.ID != 3 {
+		t.Errorf("FindFirst() = %v, %t, want item 3", item, found)
+	}
+	if got := requests.Load(); got != 2 {
+		t.Errorf("requests = %d, want 2", got)
+	}
+}
+
+func TestPaginateError(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("page") == "1" {
+			io.WriteString(w, pageOf(1, 2))
+			return
+		}
+		w.WriteHeader(http.StatusForbidden)
+		io.WriteString(w, `{"message":"Forbidden"}`)
+	})
+
+	var ids []int
+	var errs []error
+	for item, err := range Paginate[testItem](context.Background(), client, ListRequest{
+		Scope:    ScopePolicies,
+		Endpoint: "items",
+		PageSize: 2,
+		Action:   "get items",
+	}) {
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		ids = append(ids, item.ID)
+	}
+
+	if !slices.Equal(ids, []int{1, 2}) {
+		t.Errorf("items = %v, want [1 2]", ids)
+	}
+	if len(errs) != 1 {
+		t.Fatalf("got %d errors, want 1", len(errs))
+	}
+	var apiErr *APIError
+	if !errors.As(errs[0], &apiErr) || apiErr.StatusCode != http.StatusForbidden || apiErr.Message != "Forbidden" {
+		t.Errorf("error = %v, want a 403 APIError", errs[0])
+	}
+}
@@ -0,0 +1,86 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package apiclient
+
+import (
+	"context"
+	"math"
+	"sync"
+	"time"
+)
+
+// DefaultRequestsPerSecond is the request budget of each API scope used when
+// the provider configuration does not override it.
+const DefaultRequestsPerSecond = 5.0
+
+// RateLimiter is a token bucket that spaces out requests to at most rate
+// requests per second, allowing bursts of up to burst requests.
+type RateLimiter struct {
+	mu     sync.Mutex
+	rate   float64
+	burst  float64
+	tokens float64
+	last   time.Time
+}
+
+// NewRateLimiter creates a full token bucket. burst is raised to one if lower.
+func NewRateLimiter(rate float64, burst int) *RateLimiter {
+	b := float64(max(burst, 1))
+	return &RateLimiter{
+		rate:   rate,
+		burst:  b,
+		tokens: b,
+		last:   time.Now(),
+	}
+}
+
+// Wait blocks until a request may be sent or ctx is done.
+func (l *RateLimiter) Wait(ctx context.Context) error {
+	l.mu.Lock()
+	now := time.Now()
+	l.tokens = math.Min(l.burst, l.tokens+now.Sub(l.last).Seconds()*l.rate)
+	l.last = now
+
+	// Take the token now, even if it is only available in the future, so
+	// that concurrent callers queue up behind each other.
+	l.tokens--
+	var wait time.Duration
+	if l.tokens < 0 {
+		wait = time.Duration(-l.tokens / l.rate * float64(time.Second))
+	}
+	l.mu.Unlock()
+
+	if wait == 0 {
+		return nil
+	}
+	if err := sleepContext(ctx, wait); err != nil {
+		// Hand the reserved token back, the request is not going to be sent
+		l.mu.Lock()
+		l.tokens++
+		l.mu.Unlock()
+		return err
+	}
+	return nil
+}
+
+// limiter returns the rate limiter of scope, creating it on first use. It
+// returns nil when rate limiting is disabled.
+func (c *APIClient) limiter(scope string) *RateLimiter {
+	if c.RequestsPerSecond <= 0 {
+		return nil
+	}
+
+	c.limitersMu.Lock()
+	defer c.limitersMu.Unlock()
+
+	if c.limiters == nil {
+		c.limiters = make(map[string]*RateLimiter)
+	}
+	l, ok := c.limiters[scope]
+	if !ok {
+		l = NewRateLimiter(c.RequestsPerSecond, int(math.Ceil(c.RequestsPerSecond)))
+		c.limiters[scope] = l
+	}
+	return l
+}
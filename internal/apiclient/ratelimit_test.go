@@ -0,0 +1,76 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package apiclient
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestRateLimiterWait(t *testing.T) {
+	ctx := context.Background()
+	limiter := NewRateLimiter(20, 2)
+
+	// The burst is available at once
+	start := time.Now()
+	for i := 0; i < 2; i++ {
+		if err := limiter.Wait(ctx); err != nil {
+			t.Fatalf("Wait() error = %s", err)
+		}
+	}
+	if elapsed := time.Since(start); elapsed > 25*time.Millisecond {
+		t.Errorf("burst took %s, want no wait", elapsed)
+	}
+
+	// The next request waits for a token, 50ms at 20 requests per second
+	start = time.Now()
+	if err := limiter.Wait(ctx); err != nil {
+		t.Fatalf("Wait() error = %s", err)
+	}
+	if elapsed := time.Since(start); elapsed < 40*time.Millisecond {
+		t.Errorf("request after the burst waited %s, want about 50ms", elapsed)
+	}
+}
+
+func TestRateLimiterWaitCancelled(t *testing.T) {
+	limiter := NewRateLimiter(1, 1)
+	if err := limiter.Wait(context.Background()); err != nil {
+		t.Fatalf("Wait() error = %s", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if err := limiter.Wait(ctx); err == nil {
+		t.Fatal("Wait() succeeded with a cancelled context")
+	}
+
+	// The cancelled request handed its token back
+	limiter.mu.Lock()
+	tokens := limiter.tokens
+	limiter.mu.Unlock()
+	if tokens < -0.01 {
+		t.Errorf("tokens = %f after a cancelled wait, want the reservation returned", tokens)
+	}
+}
+
+func TestClientLimiter(t *testing.T) {
+	client := &APIClient{RequestsPerSecond: 5}
+
+	policies := client.limiter(ScopePolicies)
+	if policies == nil {
+		t.Fatal("limiter() = nil, want a limiter")
+	}
+	if client.limiter(ScopePolicies) != policies {
+		t.Error("limiter() returned a new limiter for the same scope")
+	}
+	if client.limiter(ScopeReports) == policies {
+		t.Error("limiter() shares a limiter between scopes")
+	}
+
+	client = &APIClient{}
+	if client.limiter(ScopePolicies) != nil {
+		t.Error("limiter() returned a limiter with rate limiting disabled")
+	}
+}
@@ -0,0 +1,71 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package apiclient
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+// Regions API endpoints
+const (
+	RegionsEndpoint = "regions"
+)
+
+// Region is a region that network tunnel groups and resource connector groups can be placed in
+type Region struct {
+	Name        string `json:"name"`
+	Region      string `json:"region"`
+	Description string `json:"description"`
+	Continent   string `json:"continent"`
+}
+
+// RegionList is the list of regions and the BGP settings of the hubs
+type RegionList struct {
+	Regions []Region `json:"regions"`
+	BGP     struct {
+		ASNumber  string   `json:"asNumber"`
+		PeerIPs   []string `json:"peerIPs"`
+		PeerRange string   `json:"peerRange"`
+	} `json:"bgp"`
+}
+
+// RegionFilters narrows down and orders the regions. Status is "available"
+// (the API default) or "all"; PeerIP sorts the regions by distance to a public IP.
+type RegionFilters struct {
+	Status string `json:"status,omitempty"`
+	PeerIP string `json:"peerIP,omitempty"`
+}
+
+// GetRegions retrieves the regions matching filters
+func GetRegions(ctx context.Context, client *APIClient, filters RegionFilters) (*RegionList, error) {
+	endpoint := RegionsEndpoint
+	if filters != (RegionFilters{}) {
+		encoded, err := json.Marshal(filters)
+		if err != nil {
+			return nil, fmt.Errorf("failed to encode region filters: %w", err)
+		}
+		endpoint += "?filters=" + url.QueryEscape(string(encoded))
+	}
+
+	resp, err := client.Query(ctx, ScopeDeployments, endpoint, OperationGet, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get regions: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, NewAPIError(resp, "get regions")
+	}
+
+	var regions RegionList
+	if err := json.NewDecoder(resp.Body).Decode(&regions); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return &regions, nil
+}
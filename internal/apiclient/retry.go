@@ -0,0 +1,118 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package apiclient
+
+import (
+	"math/rand/v2"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Retry defaults used when the provider configuration does not override them
+const (
+	DefaultMaxRetries    = 10
+	DefaultMaxRetryTime  = 5 * time.Minute
+	DefaultRetryBaseWait = 1 * time.Second
+	DefaultRetryMaxWait  = 30 * time.Second
+)
+
+// RetryPolicy decides whether and how long to wait before a failed request is retried.
+type RetryPolicy interface {
+	// NextDelay is called after the zero-based attempt failed, elapsed after
+	// the first attempt started. resp is nil when the request failed before a
+	// response was received. It returns the wait before the next attempt, or
+	// false when the request must not be retried anymore.
+	NextDelay(attempt int, elapsed time.Duration, resp *http.Response) (time.Duration, bool)
+}
+
+// BackoffRetryPolicy retries with capped exponential backoff and jitter.
+// Waits requested by the API through Retry-After or X-RateLimit-* headers
+// take precedence over the computed backoff.
+type BackoffRetryPolicy struct {
+	MaxRetries int
+	// MaxElapsed bounds the total time spent on a request including waits.
+	// Zero means no limit.
+	MaxElapsed time.Duration
+	BaseWait   time.Duration
+	MaxWait    time.Duration
+}
+
+// DefaultRetryPolicy returns the retry policy used by new API clients.
+func DefaultRetryPolicy() *BackoffRetryPolicy {
+	return &BackoffRetryPolicy{
+		MaxRetries: DefaultMaxRetries,
+		MaxElapsed: DefaultMaxRetryTime,
+		BaseWait:   DefaultRetryBaseWait,
+		MaxWait:    DefaultRetryMaxWait,
+	}
+}
+
+// NextDelay implements RetryPolicy.
+func (p *BackoffRetryPolicy) NextDelay(attempt int, elapsed time.Duration, resp *http.Response) (time.Duration, bool) {
+	if attempt >= p.MaxRetries {
+		return 0, false
+	}
+
+	delay, ok := RetryAfter(resp, time.Now())
+	if !ok {
+		delay = p.backoff(attempt)
+	}
+
+	if p.MaxElapsed > 0 && elapsed+delay > p.MaxElapsed {
+		return 0, false
+	}
+	return delay, true
+}
+
+// backoff returns the exponential backoff for attempt with "equal jitter":
+// a random wait between half and all of the capped exponential value.
+func (p *BackoffRetryPolicy) backoff(attempt int) time.Duration {
+	if p.BaseWait <= 0 {
+		return 0
+	}
+	wait := p.BaseWait
+	for i := 0; i < attempt && (p.MaxWait <= 0 || wait < p.MaxWait); i++ {
+		wait *= 2
+	}
+	if p.MaxWait > 0 && wait > p.MaxWait {
+		wait = p.MaxWait
+	}
+	half := wait / 2
+	return half + rand.N(half+1)
+}
+
+// RetryAfter returns the wait requested by the server in resp, read from the
+// Retry-After header or, once the rate limit is exhausted, X-RateLimit-Reset.
+// Both headers accept delta seconds; Retry-After also accepts an HTTP date and
+// X-RateLimit-Reset a Unix timestamp.
+func RetryAfter(resp *http.Response, now time.Time) (time.Duration, bool) {
+	if resp == nil {
+		return 0, false
+	}
+
+	if v := strings.TrimSpace(resp.Header.Get("Retry-After")); v != "" {
+		if seconds, err := strconv.Atoi(v); err == nil && seconds >= 0 {
+			return time.Duration(seconds) * time.Second, true
+		}
+		if at, err := http.ParseTime(v); err == nil {
+			return max(at.Sub(now), 0), true
+		}
+	}
+
+	if strings.TrimSpace(resp.Header.Get("X-RateLimit-Remaining")) == "0" {
+		if v := strings.TrimSpace(resp.Header.Get("X-RateLimit-Reset")); v != "" {
+			if reset, err := strconv.ParseInt(v, 10, 64); err == nil && reset >= 0 {
+				// Values this large are Unix timestamps rather than delta seconds
+				if reset > 1_000_000_000 {
+					return max(time.Unix(reset, 0).Sub(now), 0), true
+				}
+				return time.Duration(reset) * time.Second, true
+			}
+		}
+	}
+
+	return 0, false
+}
@@ -0,0 +1,117 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package apiclient
+
+import (
+	"net/http"
+	"strconv"
+	"testing"
+	"time"
+)
+
+func TestRetryAfter(t *testing.T) {
+	now := time.Date(2026, 10, 16, 12, 0, 0, 0, time.UTC)
+
+	tests := map[string]struct {
+		headers map[string]string
+		want    time.Duration
+		wantOK  bool
+	}{
+		"no headers": {},
+		"delta seconds": {
+			headers: map[string]string{"Retry-After": "7"},
+			want:    7 * time.Second,
+			wantOK:  true,
+		},
+		"zero seconds": {
+			headers: map[string]string{"Retry-After": "0"},
+			wantOK:  true,
+		},
+		"http date": {
+			headers: map[string]string{"Retry-After": now.Add(90 * time.Second).Format(http.TimeFormat)},
+			want:    90 * time.Second,
+			wantOK:  true,
+		},
+		"http date in the past": {
+			headers: map[string]string{"Retry-After": now.Add(-time.Minute).Format(http.TimeFormat)},
+			wantOK:  true,
+		},
+		"negative seconds": {
+			headers: map[string]string{"Retry-After": "-5"},
+		},
+		"invalid": {
+			headers: map[string]string{"Retry-After": "soon"},
+		},
+		"rate limit reset seconds": {
+			headers: map[string]string{"X-RateLimit-Remaining": "0", "X-RateLimit-Reset": "12"},
+			want:    12 * time.Second,
+			wantOK:  true,
+		},
+		"rate limit reset timestamp": {
+			headers: map[string]string{"X-RateLimit-Remaining": "0", "X-RateLimit-Reset": strconv.FormatInt(now.Add(30*time.Second).Unix(), 10)},
+			want:    30 * time.Second,
+			wantOK:  true,
+		},
+		"rate limit not exhausted": {
+			headers: map[string]string{"X-RateLimit-Remaining": "3", "X-RateLimit-Reset": "12"},
+		},
+		"retry after wins over rate limit reset": {
+			headers: map[string]string{"Retry-After": "2", "X-RateLimit-Remaining": "0", "X-RateLimit-Reset": "12"},
+			want:    2 * time.Second,
+			wantOK:  true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			resp := &http.Response{Header: http.Header{}}
+			for key, value := range test.headers {
+				resp.Header.Set(key, value)
+			}
+
+			got, ok := RetryAfter(resp, now)
+			if ok != test.wantOK || got != test.want {
+				t.Errorf("RetryAfter() = %s, %t, want %s, %t", got, ok, test.want, test.wantOK)
+			}
+		})
+	}
+
+	if _, ok := RetryAfter(nil, now); ok {
+		t.Error("RetryAfter(nil) reported a wait")
+	}
+}
+
+func TestBackoffRetryPolicyNextDelay(t *testing.T) {
+	policy := &BackoffRetryPolicy{
+		MaxRetries: 3,
+		MaxElapsed: time.Minute,
+		BaseWait:   time.Second,
+		MaxWait:    4 * time.Second,
+	}
+
+	for attempt, limit := range []time.Duration{time.Second, 2 * time.Second, 4 * time.Second} {
+		delay, ok := policy.NextDelay(attempt, 0, nil)
+		if !ok {
+			t.Fatalf("attempt %d: no retry, want one", attempt)
+		}
+		if delay < limit/2 || delay > limit {
+			t.Errorf("attempt %d: delay %s, want between %s and %s", attempt, delay, limit/2, limit)
+		}
+	}
+
+	if _, ok := policy.NextDelay(3, 0, nil); ok {
+		t.Error("retried after MaxRetries attempts")
+	}
+	if _, ok := policy.NextDelay(0, 59*time.Second+600*time.Millisecond, nil); ok {
+		t.Error("retried beyond MaxElapsed")
+	}
+
+	resp := &http.Response{Header: http.Header{"Retry-After": []string{"20"}}}
+	if delay, ok := policy.NextDelay(0, 0, resp); !ok || delay != 20*time.Second {
+		t.Errorf("NextDelay() = %s, %t, want the Retry-After wait of 20s", delay, ok)
+	}
+	if _, ok := policy.NextDelay(0, 50*time.Second, resp); ok {
+		t.Error("retried although the Retry-After wait exceeds MaxElapsed")
+	}
+}
@@ -0,0 +1,41 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package apiclient
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// Ruleset lock defaults used when the provider configuration does not override them
+const (
+	DefaultRuleMutationConcurrency = 1
+	DefaultWriteDelay              = 2 * time.Second
+)
+
+// lockRuleset waits for a free access rule mutation slot. The API locks the
+// whole ruleset while a rule is changed, so concurrent changes from one apply
+// queue here instead of failing with 409 Conflict. The returned release
+// function waits WriteDelay, giving the API time to drop its lock, before it
+// frees the slot.
+func (c *APIClient) lockRuleset(ctx context.Context) (func(), error) {
+	c.rulesetOnce.Do(func() {
+		c.rulesetSem = make(chan struct{}, max(c.RuleMutationConcurrency, 1))
+	})
+
+	select {
+	case c.rulesetSem <- struct{}{}:
+	case <-ctx.Done():
+		return nil, fmt.Errorf("request cancelled while waiting for the ruleset lock: %w", ctx.Err())
+	}
+
+	return func() {
+		if c.WriteDelay > 0 {
+			// A cancelled context only shortens the wait, the slot is freed regardless
+			_ = sleepContext(ctx, c.WriteDelay)
+		}
+		<-c.rulesetSem
+	}, nil
+}
@@ -4,12 +4,16 @@
 package apiclient
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
-	"io"
 	"net/http"
 )
 
+// SecurityProfileDetailsEndpoint returns a single security profile. The
+// Security Profiles API is read-only.
+const SecurityProfileDetailsEndpoint = "securityProfiles/%d"
+
 type SecurityProfile struct {
 	ID             int    `json:"id"`
 	OrganizationID int    `json:"organizationId"`
@@ -20,29 +24,49 @@ type SecurityProfile struct {
 	Priority       int    `json:"priority"`
 }
 
-func (c *APIClient) GetSecurityProfiles() ([]SecurityProfile, error) {
-	endpoint := "securityProfiles"
-	resp, err := c.Query(ScopePolicies, endpoint, http.MethodGet, nil)
+// SecurityProfileDetails is a security profile with the IDs of the setting
+// groups it links to.
+type SecurityProfileDetails struct {
+	SecurityProfile
+	IsSwgDefault                        bool  `json:"isSwgDefault"`
+	IsFwaasDefault                      bool  `json:"isFwaasDefault"`
+	SecuritySettingGroupID              int64 `json:"securitySettingGroupId"`
+	PolicySettingGroupID                int64 `json:"policySettingGroupId"`
+	FileInspectionSettingGroupID        int64 `json:"fileInspectionSettingGroupId"`
+	SettingGroupBypassInspectionGroupID int64 `json:"settingGroupBypassInspectionGroupId"`
+	// TenantControlsRestriction is returned as "restriction"; the
+	// specification names its schema tenantControlsRestriction.
+	TenantControlsRestriction *struct {
+		ID   int64  `json:"id"`
+		Name string `json:"name"`
+	} `json:"restriction,omitempty"`
+}
+
+func (c *APIClient) GetSecurityProfiles(ctx context.Context) ([]SecurityProfile, error) {
+	return CollectAll(Paginate[SecurityProfile](ctx, c, ListRequest{
+		Scope:    ScopePolicies,
+		Endpoint: "securityProfiles",
+		Style:    PageNumber,
+		Action:   "get security profiles",
+	}))
+}
+
+// GetSecurityProfile retrieves a security profile and its linked settings by ID
+func GetSecurityProfile(ctx context.Context, client *APIClient, id int64) (*SecurityProfileDetails, error) {
+	resp, err := client.Query(ctx, ScopePolicies, fmt.Sprintf(SecurityProfileDetailsEndpoint, id), OperationGet, nil)
 	if err != nil {
-		return nil, err
+		return nil, fmt.Errorf("failed to get security profile: %w", err)
 	}
 	defer resp.Body.Close()
 
 	if resp.StatusCode != http.StatusOK {
-		body, _ := io.ReadAll(resp.Body)
-		return nil, fmt.Errorf("API request failed with status %d: %s", resp.StatusCode, string(body))
-	}
-
-	body, err := io.ReadAll(resp.Body)
-	if err != nil {
-		return nil, fmt.Errorf("failed to read response body: %w", err)
+		return nil, NewAPIError(resp, fmt.Sprintf("get security profile %d", id))
 	}
 
-	// The API returns a direct array of objects
-	var profiles []SecurityProfile
-	if err := json.Unmarshal(body, &profiles); err != nil {
+	var profile SecurityProfileDetails
+	if err := json.NewDecoder(resp.Body).Decode(&profile); err != nil {
 		return nil, fmt.Errorf("failed to decode response: %w", err)
 	}
 
-	return profiles, nil
+	return &profile, nil
 }
@@ -4,15 +4,17 @@
 package apiclient
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
-	"io"
+	"iter"
 	"net/http"
 )
 
 const (
-	ServiceObjectsEndpoint       = "objects/serviceObjects"
-	ServiceObjectDetailsEndpoint = "objects/serviceObjects/%d"
+	ServiceObjectsEndpoint         = "objects/serviceObjects"
+	ServiceObjectDetailsEndpoint   = "objects/serviceObjects/%d"
+	ServiceObjectsValidateEndpoint = "objects/serviceObjects/validate"
 )
 
 type ServiceObjectValue struct {
@@ -31,13 +33,6 @@ type ServiceObject struct {
 	ModifiedBy  string             `json:"modified_by,omitempty"`
 }
 
-type ServiceObjectResponse struct {
-	Count   int             `json:"count"`
-	Limit   int             `json:"limit"`
-	Offset  int             `json:"offset"`
-	Results []ServiceObject `json:"results"`
-}
-
 type CreateServiceObjectPayload struct {
 	Name        string             `json:"name"`
 	Description string             `json:"description,omitempty"`
@@ -51,53 +46,37 @@ type UpdateServiceObjectPayload struct {
 }
 
 // GetServiceObjects retrieves all service objects
-func GetServiceObjects(client *APIClient) ([]ServiceObject, error) {
-	var allObjects []ServiceObject
-	offset := 0
-	limit := 100
-
-	for {
-		endpoint := fmt.Sprintf("%s?offset=%d&limit=%d", ServiceObjectsEndpoint, offset, limit)
-		resp, err := client.Query(ScopePolicies, endpoint, OperationGet, nil)
-		if err != nil {
-			return nil, fmt.Errorf("failed to get service objects: %w", err)
-		}
-
-		if resp.StatusCode != http.StatusOK {
-			body, _ := io.ReadAll(resp.Body)
-			resp.Body.Close()
-			return nil, fmt.Errorf("failed to get service objects. Status: %d, Response: %s", resp.StatusCode, string(body))
-		}
-
-		var result ServiceObjectResponse
-		if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
-			resp.Body.Close()
-			return nil, fmt.Errorf("failed to decode response: %w", err)
-		}
-		resp.Body.Close()
-
-		allObjects = append(allObjects, result.Results...)
-
-		if len(result.Results) == 0 || offset+len(result.Results) >= result.Count {
-			break
-		}
-		offset += len(result.Results)
-	}
+func GetServiceObjects(ctx context.Context, client *APIClient) ([]ServiceObject, error) {
+	return CollectAll(serviceObjects(ctx, client))
+}
 
-	return allObjects, nil
+// serviceObjects iterates over the service objects of the organization.
+func serviceObjects(ctx context.Context, client *APIClient) iter.Seq2[ServiceObject, error] {
+	return Paginate[ServiceObject](ctx, client, ListRequest{
+		Scope:    ScopePolicies,
+		Endpoint: ServiceObjectsEndpoint,
+		Style:    PageOffset,
+		Action:   "get service objects",
+	})
+}
+
+// ValidateServiceObjects has the API validate service objects without
+// creating them. Rejected objects are returned as an *ObjectValidationError
+// whose rows follow the order of objects.
+func ValidateServiceObjects(ctx context.Context, client *APIClient, objects []CreateServiceObjectPayload) error {
+	return validateObjects(ctx, client, ServiceObjectsValidateEndpoint, objects, "service objects")
 }
 
 // CreateServiceObject creates a new service object
-func CreateServiceObject(client *APIClient, payload CreateServiceObjectPayload) (*ServiceObject, error) {
-	resp, err := client.Query(ScopePolicies, ServiceObjectsEndpoint, OperationPost, payload)
+func CreateServiceObject(ctx context.Context, client *APIClient, payload CreateServiceObjectPayload) (*ServiceObject, error) {
+	resp, err := client.Query(ctx, ScopePolicies, ServiceObjectsEndpoint, OperationPost, payload)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create service object: %w", err)
 	}
 	defer resp.Body.Close()
 
 	if resp.StatusCode != http.StatusCreated {
-		body, _ := io.ReadAll(resp.Body)
-		return nil, fmt.Errorf("failed to create service object. Status: %d, Response: %s", resp.StatusCode, string(body))
+		return nil, NewAPIError(resp, "create service object")
 	}
 
 	var object ServiceObject
@@ -109,17 +88,16 @@ func CreateServiceObject(client *APIClient, payload CreateServiceObjectPayload)
 }
 
 // GetServiceObjectDetails retrieves details of a specific service object
-func GetServiceObjectDetails(client *APIClient, objectID int64) (*ServiceObject, error) {
+func GetServiceObjectDetails(ctx context.Context, client *APIClient, objectID int64) (*ServiceObject, error) {
 	endpoint := fmt.Sprintf(ServiceObjectDetailsEndpoint, objectID)
-	resp, err := client.Query(ScopePolicies, endpoint, OperationGet, nil)
+	resp, err := client.Query(ctx, ScopePolicies, endpoint, OperationGet, nil)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get service object details: %w", err)
 	}
 	defer resp.Body.Close()
 
 	if resp.StatusCode != http.StatusOK {
-		body, _ := io.ReadAll(resp.Body)
-		return nil, fmt.Errorf("failed to get service object %d. Status: %d, Response: %s", objectID, resp.StatusCode, string(body))
+		return nil, NewAPIError(resp, fmt.Sprintf("get service object %d", objectID))
 	}
 
 	var object ServiceObject
@@ -131,17 +109,16 @@ func GetServiceObjectDetails(client *APIClient, objectID int64) (*ServiceObject,
 }
 
 // UpdateServiceObject updates a service object
-func UpdateServiceObject(client *APIClient, objectID int64, payload UpdateServiceObjectPayload) (*ServiceObject, error) {
+func UpdateServiceObject(ctx context.Context, client *APIClient, objectID int64, payload UpdateServiceObjectPayload) (*ServiceObject, error) {
 	endpoint := fmt.Sprintf(ServiceObjectDetailsEndpoint, objectID)
-	resp, err := client.Query(ScopePolicies, endpoint, OperationPut, payload)
+	resp, err := client.Query(ctx, ScopePolicies, endpoint, OperationPut, payload)
 	if err != nil {
 		return nil, fmt.Errorf("failed to update service object: %w", err)
 	}
 	defer resp.Body.Close()
 
 	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusCreated {
-		body, _ := io.ReadAll(resp.Body)
-		return nil, fmt.Errorf("failed to update service object %d. Status: %d, Response: %s", objectID, resp.StatusCode, string(body))
+		return nil, NewAPIError(resp, fmt.Sprintf("update service object %d", objectID))
 	}
 
 	var object ServiceObject
@@ -153,57 +130,29 @@ func UpdateServiceObject(client *APIClient, objectID int64, payload UpdateServic
 }
 
 // DeleteServiceObject deletes a service object
-func DeleteServiceObject(client *APIClient, objectID int64) error {
+func DeleteServiceObject(ctx context.Context, client *APIClient, objectID int64) error {
 	endpoint := fmt.Sprintf(ServiceObjectDetailsEndpoint, objectID)
-	resp, err := client.Query(ScopePolicies, endpoint, OperationDelete, nil)
+	resp, err := client.Query(ctx, ScopePolicies, endpoint, OperationDelete, nil)
 	if err != nil {
 		return fmt.Errorf("failed to delete service object: %w", err)
 	}
 	defer resp.Body.Close()
 
 	if resp.StatusCode != http.StatusNoContent {
-		body, _ := io.ReadAll(resp.Body)
-		return fmt.Errorf("failed to delete service object %d. Status: %d, Response: %s", objectID, resp.StatusCode, string(body))
+		return NewAPIError(resp, fmt.Sprintf("delete service object %d", objectID))
 	}
 
 	return nil
 }
 
-func GetServiceObjectIDByName(client *APIClient, name string) (int64, error) {
-	offset := 0
-	limit := 100
-
-	for {
-		endpoint := fmt.Sprintf("%s?offset=%d&limit=%d", ServiceObjectsEndpoint, offset, limit)
-		resp, err := client.Query(ScopePolicies, endpoint, OperationGet, nil)
-		if err != nil {
-			return 0, fmt.Errorf("failed to get service objects: %w", err)
-		}
-
-		if resp.StatusCode != http.StatusOK {
-			body, _ := io.ReadAll(resp.Body)
-			resp.Body.Close()
-			return 0, fmt.Errorf("failed to get service objects. Status: %d, Response: %s", resp.StatusCode, string(body))
-		}
-
-		var result ServiceObjectResponse
-		if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
-			resp.Body.Close()
-			return 0, fmt.Errorf("failed to decode response: %w", err)
-		}
-		resp.Body.Close()
-
-		for _, obj := range result.Results {
-			if obj.Name == name {
-				return obj.ID, nil
-			}
-		}
-
-		if len(result.Results) == 0 || offset+len(result.Results) >= result.Count {
-			break
-		}
-		offset += len(result.Results)
+func GetServiceObjectIDByName(ctx context.Context, client *APIClient, name string) (int64, error) {
+	object, found, err := FindFirst(serviceObjects(ctx, client), func(o ServiceObject) bool { return o.Name == name })
+	if err != nil {
+		return 0, err
+	}
+	if !found {
+		return 0, fmt.Errorf("service object with name '%s' not found", name)
 	}
 
-	return 0, fmt.Errorf("service object with name '%s' not found", name)
+	return object.ID, nil
 }
@@ -0,0 +1,153 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package apiclient
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"iter"
+	"net/http"
+	"net/url"
+)
+
+// Service Object Groups API endpoints
+const (
+	ServiceObjectGroupsEndpoint       = "objects/serviceObjectGroups"
+	ServiceObjectGroupDetailsEndpoint = "objects/serviceObjectGroups/%d"
+)
+
+// ServiceObjectGroup represents a group of service objects, nested service
+// object groups and inline values.
+type ServiceObjectGroup struct {
+	ID          int64                `json:"id"`
+	Name        string               `json:"name"`
+	Description string               `json:"description,omitempty"`
+	Objects     []ObjectGroupMember  `json:"objects"`
+	Groups      []ObjectGroupMember  `json:"groups"`
+	Values      []ServiceObjectValue `json:"values"`
+	URL         string               `json:"url,omitempty"`
+	CreatedAt   string               `json:"created_at,omitempty"`
+	ModifiedAt  string               `json:"modified_at,omitempty"`
+	ModifiedBy  string               `json:"modified_by,omitempty"`
+}
+
+// ServiceObjectGroupPayload is the request body to create or update a service
+// object group. The API requires all of the lists, even when they are empty.
+type ServiceObjectGroupPayload struct {
+	Name        string               `json:"name"`
+	Description string               `json:"description,omitempty"`
+	ObjectIDs   []int64              `json:"objectIds"`
+	GroupIDs    []int64              `json:"groupIds"`
+	Values      []ServiceObjectValue `json:"values"`
+}
+
+// GetServiceObjectGroups retrieves all service object groups
+func GetServiceObjectGroups(ctx context.Context, client *APIClient) ([]ServiceObjectGroup, error) {
+	return CollectAll(serviceObjectGroups(ctx, client, ""))
+}
+
+// serviceObjectGroups iterates over the service object groups, optionally
+// filtered by name on the server.
+func serviceObjectGroups(ctx context.Context, client *APIClient, name string) iter.Seq2[ServiceObjectGroup, error] {
+	endpoint := ServiceObjectGroupsEndpoint
+	if name != "" {
+		endpoint += "?name=" + url.QueryEscape(name)
+	}
+	return Paginate[ServiceObjectGroup](ctx, client, ListRequest{
+		Scope:    ScopePolicies,
+		Endpoint: endpoint,
+		Style:    PageOffset,
+		Action:   "get service object groups",
+	})
+}
+
+// GetServiceObjectGroupIDByName returns the ID of the service object group with the given name
+func GetServiceObjectGroupIDByName(ctx context.Context, client *APIClient, name string) (int64, error) {
+	group, found, err := FindFirst(serviceObjectGroups(ctx, client, name), func(g ServiceObjectGroup) bool { return g.Name == name })
+	if err != nil {
+		return 0, err
+	}
+	if !found {
+		return 0, fmt.Errorf("service object group with name '%s' not found", name)
+	}
+
+	return group.ID, nil
+}
+
+// GetServiceObjectGroup retrieves a service object group by ID
+func GetServiceObjectGroup(ctx context.Context, client *APIClient, id int64) (*ServiceObjectGroup, error) {
+	resp, err := client.Query(ctx, ScopePolicies, fmt.Sprintf(ServiceObjectGroupDetailsEndpoint, id), OperationGet, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get service object group: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, NewAPIError(resp, fmt.Sprintf("get service object group %d", id))
+	}
+
+	var group ServiceObjectGroup
+	if err := json.NewDecoder(resp.Body).Decode(&group); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return &group, nil
+}
+
+// CreateServiceObjectGroup creates a new service object group
+func CreateServiceObjectGroup(ctx context.Context, client *APIClient, payload ServiceObjectGroupPayload) (*ServiceObjectGroup, error) {
+	resp, err := client.Query(ctx, ScopePolicies, ServiceObjectGroupsEndpoint, OperationPost, payload)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create service object group: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusCreated {
+		return nil, NewAPIError(resp, fmt.Sprintf("create service object group '%s'", payload.Name))
+	}
+
+	var group ServiceObjectGroup
+	if err := json.NewDecoder(resp.Body).Decode(&group); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	logDebug(ctx, ScopePolicies, "Created service object group", map[string]interface{}{"group_id": group.ID, "group_name": group.Name})
+	return &group, nil
+}
+
+// UpdateServiceObjectGroup replaces the properties of a service object group
+func UpdateServiceObjectGroup(ctx context.Context, client *APIClient, id int64, payload ServiceObjectGroupPayload) (*ServiceObjectGroup, error) {
+	resp, err := client.Query(ctx, ScopePolicies, fmt.Sprintf(ServiceObjectGroupDetailsEndpoint, id), OperationPut, payload)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update service object group: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusCreated {
+		return nil, NewAPIError(resp, fmt.Sprintf("update service object group %d", id))
+	}
+
+	var group ServiceObjectGroup
+	if err := json.NewDecoder(resp.Body).Decode(&group); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return &group, nil
+}
+
+// DeleteServiceObjectGroup deletes a service object group
+func DeleteServiceObjectGroup(ctx context.Context, client *APIClient, id int64) error {
+	resp, err := client.Query(ctx, ScopePolicies, fmt.Sprintf(ServiceObjectGroupDetailsEndpoint, id), OperationDelete, nil)
+	if err != nil {
+		return fmt.Errorf("failed to delete service object group: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusNoContent {
+		return NewAPIError(resp, fmt.Sprintf("delete service object group %d", id))
+	}
+
+	return nil
+}
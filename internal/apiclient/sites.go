@@ -0,0 +1,140 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package apiclient
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"iter"
+	"net/http"
+)
+
+// Sites API endpoints
+const (
+	SitesEndpoint       = "sites"
+	SiteDetailsEndpoint = "sites/%d"
+)
+
+// Site represents a site that groups internal networks and virtual appliances
+type Site struct {
+	SiteID               int64  `json:"siteId"`
+	OriginID             int64  `json:"originId"`
+	Name                 string `json:"name"`
+	IsDefault            bool   `json:"isDefault"`
+	Type                 string `json:"type,omitempty"`
+	InternalNetworkCount int    `json:"internalNetworkCount"`
+	VACount              int    `json:"vaCount"`
+	CreatedAt            string `json:"createdAt,omitempty"`
+	ModifiedAt           string `json:"modifiedAt,omitempty"`
+}
+
+// SitePayload is the request body to create or update a site
+type SitePayload struct {
+	Name string `json:"name"`
+}
+
+// GetSites retrieves all sites
+func GetSites(ctx context.Context, client *APIClient) ([]Site, error) {
+	return CollectAll(sites(ctx, client))
+}
+
+// sites iterates over the sites of the organization.
+func sites(ctx context.Context, client *APIClient) iter.Seq2[Site, error] {
+	return Paginate[Site](ctx, client, ListRequest{
+		Scope:    ScopeDeployments,
+		Endpoint: SitesEndpoint,
+		Style:    PageNumber,
+		Action:   "get sites",
+	})
+}
+
+// GetSiteByName returns the site with the given name
+func GetSiteByName(ctx context.Context, client *APIClient, name string) (*Site, error) {
+	site, found, err := FindFirst(sites(ctx, client), func(s Site) bool { return s.Name == name })
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, fmt.Errorf("site with name '%s' not found", name)
+	}
+
+	return &site, nil
+}
+
+// GetSite retrieves a site by ID
+func GetSite(ctx context.Context, client *APIClient, id int64) (*Site, error) {
+	resp, err := client.Query(ctx, ScopeDeployments, fmt.Sprintf(SiteDetailsEndpoint, id), OperationGet, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get site: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, NewAPIError(resp, fmt.Sprintf("get site %d", id))
+	}
+
+	var site Site
+	if err := json.NewDecoder(resp.Body).Decode(&site); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return &site, nil
+}
+
+// CreateSite creates a new site
+func CreateSite(ctx context.Context, client *APIClient, payload SitePayload) (*Site, error) {
+	resp, err := client.Query(ctx, ScopeDeployments, SitesEndpoint, OperationPost, payload)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create site: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusCreated {
+		return nil, NewAPIError(resp, "create site")
+	}
+
+	var site Site
+	if err := json.NewDecoder(resp.Body).Decode(&site); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	logDebug(ctx, ScopeDeployments, "Created site", map[string]interface{}{"site_id": site.SiteID, "name": site.Name})
+	return &site, nil
+}
+
+// UpdateSite renames a site
+func UpdateSite(ctx context.Context, client *APIClient, id int64, payload SitePayload) (*Site, error) {
+	resp, err := client.Query(ctx, ScopeDeployments, fmt.Sprintf(SiteDetailsEndpoint, id), OperationPut, payload)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update site: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, NewAPIError(resp, fmt.Sprintf("update site %d", id))
+	}
+
+	var site Site
+	if err := json.NewDecoder(resp.Body).Decode(&site); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return &site, nil
+}
+
+// DeleteSite deletes a site
+func DeleteSite(ctx context.Context, client *APIClient, id int64) error {
+	resp, err := client.Query(ctx, ScopeDeployments, fmt.Sprintf(SiteDetailsEndpoint, id), OperationDelete, nil)
+	if err != nil {
+		return fmt.Errorf("failed to delete site: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusNoContent {
+		return NewAPIError(resp, fmt.Sprintf("delete site %d", id))
+	}
+
+	return nil
+}
@@ -0,0 +1,87 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package apiclient
+
+import (
+	"context"
+	"iter"
+	"net/url"
+)
+
+// Unified Objects API endpoints. They list objects and object groups,
+// including the system-defined ones, in one collection.
+const (
+	UnifiedNetworkObjectsEndpoint = "objects/unifiedNetworkObjects"
+	UnifiedServiceObjectsEndpoint = "objects/unifiedServiceObjects"
+)
+
+// UnifiedObjectGroupType is the type of the object groups in the unified collections
+const UnifiedObjectGroupType = "group"
+
+// UnifiedNetworkObject is a network object or a network object group. Type is
+// the type of the object (host, network, range or fqdn) or "group". Value is
+// only set for objects, Objects, Groups and Values only for groups.
+type UnifiedNetworkObject struct {
+	ID          int64                `json:"id"`
+	Name        string               `json:"name"`
+	Description string               `json:"description,omitempty"`
+	Type        string               `json:"type,omitempty"`
+	Value       *NetworkObjectValue  `json:"value,omitempty"`
+	Objects     []ObjectGroupMember  `json:"objects,omitempty"`
+	Groups      []ObjectGroupMember  `json:"groups,omitempty"`
+	Values      []NetworkObjectValue `json:"values,omitempty"`
+}
+
+// UnifiedServiceObject is a service object or a service object group. Type is
+// the protocol of the object (tcp, udp, icmp or any) or "group". Value is only
+// set for objects, Objects, Groups and Values only for groups.
+type UnifiedServiceObject struct {
+	ID          int64                `json:"id"`
+	Name        string               `json:"name"`
+	Description string               `json:"description,omitempty"`
+	Type        string               `json:"type,omitempty"`
+	Value       *ServiceObjectValue  `json:"value,omitempty"`
+	Objects     []ObjectGroupMember  `json:"objects,omitempty"`
+	Groups      []ObjectGroupMember  `json:"groups,omitempty"`
+	Values      []ServiceObjectValue `json:"values,omitempty"`
+}
+
+// GetUnifiedNetworkObjectsByName returns the network objects and network
+// object groups with the given name.
+func GetUnifiedNetworkObjectsByName(ctx context.Context, client *APIClient, name string) ([]UnifiedNetworkObject, error) {
+	return collectByName(unifiedObjects[UnifiedNetworkObject](ctx, client, UnifiedNetworkObjectsEndpoint, name, "get unified network objects"),
+		func(o UnifiedNetworkObject) string { return o.Name }, name)
+}
+
+// GetUnifiedServiceObjectsByName returns the service objects and service
+// object groups with the given name.
+func GetUnifiedServiceObjectsByName(ctx context.Context, client *APIClient, name string) ([]UnifiedServiceObject, error) {
+	return collectByName(unifiedObjects[UnifiedServiceObject](ctx, client, UnifiedServiceObjectsEndpoint, name, "get unified service objects"),
+		func(o UnifiedServiceObject) string { return o.Name }, name)
+}
+
+// unifiedObjects iterates over a unified collection, filtered by name on the
+// server. The server also returns partial matches.
+func unifiedObjects[T any](ctx context.Context, client *APIClient, endpoint, name, action string) iter.Seq2[T, error] {
+	return Paginate[T](ctx, client, ListRequest{
+		Scope:    ScopePolicies,
+		Endpoint: endpoint + "?name=" + url.QueryEscape(name),
+		Style:    PageOffset,
+		Action:   action,
+	})
+}
+
+// collectByName returns the items of seq whose name is exactly name.
+func collectByName[T any](seq iter.Seq2[T, error], nameOf func(T) string, name string) ([]T, error) {
+	var matches []T
+	for item, err := range seq {
+		if err != nil {
+			return nil, err
+		}
+		if nameOf(item) == name {
+			matches = append(matches, item)
+		}
+	}
+	return matches, nil
+}
@@ -19,6 +19,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces.
@@ -280,7 +281,7 @@ func (r *AccessRuleResource) Create(ctx context.Context, req resource.CreateRequ
 		RuleSettings:    settings,
 	}
 
-	createdRule, err := apiclient.CreateAccessRule(r.client, reqPayload)
+	createdRule, err := apiclient.CreateAccessRule(ctx, r.client, reqPayload)
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create access rule, got error: %s", err))
 		return
@@ -309,20 +310,21 @@ func (r *AccessRuleResource) Read(ctx context.Context, req resource.ReadRequest,
 		return
 	}
 
-	rule, err := apiclient.GetAccessRuleDetails(r.client, int(data.ID.ValueInt64()))
+	rule, err := apiclient.GetAccessRuleDetails(ctx, r.client, int(data.ID.ValueInt64()))
+	if removeMissingResource(ctx, resp, err, "Access rule", strconv.FormatInt(data.ID.ValueInt64(), 10)) {
+		return
+	}
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read access rule, got error: %s", err))
 		return
 	}
 
-	// DEBUG: Print rule details
-	fmt.Printf("[DEBUG] AccessRule Read ID=%d Priority=%d\n", rule.RuleID, rule.RulePriority)
-	for i, c := range rule.RuleConditions {
-		fmt.Printf("[DEBUG] Condition[%d]: Name=%s Op=%s ValueType=%T Value=%v\n", i, c.AttributeName, c.AttributeOperator, c.AttributeValue, c.AttributeValue)
-	}
-	for i, s := range rule.RuleSettings {
-		fmt.Printf("[DEBUG] Setting[%d]: Name=%s ValueType=%T Value=%v\n", i, s.SettingName, s.SettingValue, s.SettingValue)
-	}
+	tflog.Debug(ctx, "Read access rule", map[string]interface{}{
+		"rule_id":         rule.RuleID,
+		"rule_priority":   rule.RulePriority,
+		"condition_count": len(rule.RuleConditions),
+		"setting_count":   len(rule.RuleSettings),
+	})
 
 	data.Name = types.StringValue(rule.RuleName)
 	if rule.RuleDescription != "" {
@@ -494,7 +496,7 @@ func (r *AccessRuleResource) Update(ctx context.Context, req resource.UpdateRequ
 		RuleSettings:    settings,
 	}
 
-	updatedRule, err := apiclient.UpdateAccessRule(r.client, int(data.ID.ValueInt64()), reqPayload)
+	updatedRule, err := apiclient.UpdateAccessRule(ctx, r.client, int(data.ID.ValueInt64()), reqPayload)
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update access rule, got error: %s", err))
 		return
@@ -518,7 +520,7 @@ func (r *AccessRuleResource) Delete(ctx context.Context, req resource.DeleteRequ
 		return
 	}
 
-	err := apiclient.DeleteAccessRule(r.client, int(data.ID.ValueInt64()))
+	err := apiclient.DeleteAccessRule(ctx, r.client, int(data.ID.ValueInt64()))
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete access rule, got error: %s", err))
 		return
@@ -529,7 +531,7 @@ func (r *AccessRuleResource) ImportState(ctx context.Context, req resource.Impor
 	id := req.ID
 	if _, err := strconv.ParseInt(id, 10, 64); err != nil {
 		// Not a number, try to find by name
-		foundID, err := apiclient.GetAccessRuleIDByName(r.client, id)
+		foundID, err := apiclient.GetAccessRuleIDByName(ctx, r.client, id)
 		if err != nil {
 			resp.Diagnostics.AddError(
 				"Error importing access rule",
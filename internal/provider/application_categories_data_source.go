@@ -85,7 +85,7 @@ func (d *ApplicationCategoriesDataSource) Configure(ctx context.Context, req dat
 func (d *ApplicationCategoriesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
 	var state ApplicationCategoriesDataSourceModel
 
-	categories, err := d.client.GetApplicationCategories()
+	categories, err := d.client.GetApplicationCategories(ctx)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Unable to Read Application Categories",
@@ -82,7 +82,7 @@ func (d *ApplicationDataSource) Read(ctx context.Context, req datasource.ReadReq
 
 	targetName := state.Name.ValueString()
 
-	apps, err := d.client.GetApplications()
+	apps, err := d.client.GetApplications(ctx)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Unable to Read Applications",
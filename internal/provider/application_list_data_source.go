@@ -0,0 +1,140 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cisco/terraform-provider-sse/internal/apiclient"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &ApplicationListDataSource{}
+
+func NewApplicationListDataSource() datasource.DataSource {
+	return &ApplicationListDataSource{}
+}
+
+type ApplicationListDataSource struct {
+	client *apiclient.APIClient
+}
+
+type ApplicationListDataSourceModel struct {
+	ID                     types.Int64   `tfsdk:"id"`
+	Name                   types.String  `tfsdk:"name"`
+	IsDefault              types.Bool    `tfsdk:"is_default"`
+	ApplicationIDs         []types.Int64 `tfsdk:"application_ids"`
+	ApplicationCategoryIDs []types.Int64 `tfsdk:"application_category_ids"`
+}
+
+func (d *ApplicationListDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_application_list"
+}
+
+func (d *ApplicationListDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Fetches a single Application List by ID or name.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "The ID of the application list to find. Either `id` or `name` must be set.",
+			},
+			"name": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "The name of the application list to find. Either `id` or `name` must be set.",
+			},
+			"is_default": schema.BoolAttribute{
+				Computed:    true,
+				Description: "Whether the application list is the default list of the organization.",
+			},
+			"application_ids": schema.ListAttribute{
+				Computed:    true,
+				ElementType: types.Int64Type,
+				Description: "The IDs of the applications in the list.",
+			},
+			"application_category_ids": schema.ListAttribute{
+				Computed:    true,
+				ElementType: types.Int64Type,
+				Description: "The IDs of the application categories in the list.",
+			},
+		},
+	}
+}
+
+func (d *ApplicationListDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*apiclient.APIClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *apiclient.APIClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *ApplicationListDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state ApplicationListDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if state.ID.IsNull() && state.Name.IsNull() {
+		resp.Diagnostics.AddError(
+			"Missing Application List Identifier",
+			"Either id or name must be set to look up an application list.",
+		)
+		return
+	}
+
+	id := state.ID.ValueInt64()
+	if state.ID.IsNull() {
+		var err error
+		id, err = apiclient.GetApplicationListIDByName(ctx, d.client, state.Name.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Application List Not Found",
+				err.Error(),
+			)
+			return
+		}
+	}
+
+	list, err := apiclient.GetApplicationList(ctx, d.client, id)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Application List",
+			err.Error(),
+		)
+		return
+	}
+
+	state.ID = types.Int64Value(list.ID)
+	state.Name = types.StringValue(list.Name)
+	state.IsDefault = types.BoolValue(list.IsDefault)
+	state.ApplicationIDs = int64Values(list.ApplicationIDs)
+	state.ApplicationCategoryIDs = int64Values(list.ApplicationCategoryIDs)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func int64Values(ids []int64) []types.Int64 {
+	values := []types.Int64{}
+	for _, id := range ids {
+		values = append(values, types.Int64Value(id))
+	}
+	return values
+}
@@ -0,0 +1,483 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/cisco/terraform-provider-sse/internal/apiclient"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ resource.Resource = &ApplicationListResource{}
+var _ resource.ResourceWithImportState = &ApplicationListResource{}
+var _ resource.ResourceWithValidateConfig = &ApplicationListResource{}
+var _ resource.ResourceWithModifyPlan = &ApplicationListResource{}
+
+func NewApplicationListResource() resource.Resource {
+	return &ApplicationListResource{}
+}
+
+type ApplicationListResource struct {
+	client *apiclient.APIClient
+}
+
+type ApplicationListResourceModel struct {
+	ID                     types.Int64  `tfsdk:"id"`
+	Name                   types.String `tfsdk:"name"`
+	IsDefault              types.Bool   `tfsdk:"is_default"`
+	ApplicationIDs         types.Set    `tfsdk:"application_ids"`
+	ApplicationNames       types.Set    `tfsdk:"application_names"`
+	ApplicationCategoryIDs types.Set    `tfsdk:"application_category_ids"`
+	ApplicationNameIDs     types.Map    `tfsdk:"application_name_ids"`
+}
+
+func (r *ApplicationListResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_application_list"
+}
+
+func (r *ApplicationListResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages an Application List. App-control rules reference the list instead of repeating the same applications and application categories.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				Computed:    true,
+				Description: "The ID of the Application List.",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:    true,
+				Description: "The name of the Application List.",
+			},
+			"is_default": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+				Description: "Whether the Application List is the default list of the organization. Defaults to `false`.",
+			},
+			"application_ids": schema.SetAttribute{
+				Optional:    true,
+				ElementType: types.Int64Type,
+				Description: "The IDs of the applications in the list. Use the `id` attribute of `sse_application`.",
+			},
+			"application_names": schema.SetAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: "The names (labels) of the applications in the list. They are resolved to IDs with the applications of the Reporting API, the same catalog as `sse_application`. A name shared by several applications is rejected.",
+			},
+			"application_category_ids": schema.SetAttribute{
+				Optional:    true,
+				ElementType: types.Int64Type,
+				Description: "The IDs of the application categories in the list. Use the `id` attribute of `sse_application_categories`.",
+			},
+			"application_name_ids": schema.MapAttribute{
+				Computed:    true,
+				ElementType: types.Int64Type,
+				Description: "The IDs of the applications in `application_names`, keyed by name.",
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *ApplicationListResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config ApplicationListResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The API returns no members as empty lists, which are read back as
+	// unset attributes.
+	for _, attr := range []struct {
+		name string
+		set  types.Set
+	}{
+		{"application_ids", config.ApplicationIDs},
+		{"application_names", config.ApplicationNames},
+		{"application_category_ids", config.ApplicationCategoryIDs},
+	} {
+		if !attr.set.IsNull() && !attr.set.IsUnknown() && len(attr.set.Elements()) == 0 {
+			resp.Diagnostics.AddAttributeError(
+				path.Root(attr.name),
+				"Empty Application List Members",
+				fmt.Sprintf("%s must not be empty. Omit the attribute instead.", attr.name),
+			)
+		}
+	}
+}
+
+func (r *ApplicationListResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*apiclient.APIClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *apiclient.APIClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+// ModifyPlan marks application_name_ids as unknown when application_names
+// change and checks that the new names are known applications, so that typos
+// fail at plan time.
+func (r *ApplicationListResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to check when destroying
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan, state ApplicationListResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if !req.State.Raw.IsNull() {
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	}
+	if resp.Diagnostics.HasError() || (!req.State.Raw.IsNull() && plan.ApplicationNames.Equal(state.ApplicationNames)) {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("application_name_ids"), types.MapUnknown(types.Int64Type))...)
+
+	if r.client == nil || plan.ApplicationNames.IsNull() || plan.ApplicationNames.IsUnknown() {
+		return
+	}
+
+	var names []types.String
+	resp.Diagnostics.Append(plan.ApplicationNames.ElementsAs(ctx, &names, false)...)
+	if resp.Diagnostics.HasError() || !knownStrings(names...) {
+		return
+	}
+
+	apps, err := r.client.GetApplications(ctx)
+	if err != nil {
+		resp.Diagnostics.AddAttributeWarning(
+			path.Root("application_names"),
+			"Unable to Validate Application Names",
+			"The application names could not be checked against the list of applications: "+err.Error(),
+		)
+		return
+	}
+
+	_, d := applicationIDsByName(apps, stringValues(names))
+	resp.Diagnostics.Append(d...)
+}
+
+func (r *ApplicationListResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan ApplicationListResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	payload, diags := r.applicationListPayload(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	list, err := apiclient.CreateApplicationList(ctx, r.client, payload)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating Application List",
+			"Could not create application list, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(mapApplicationListToModel(ctx, list, &plan)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *ApplicationListResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state ApplicationListResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := state.ID.ValueInt64()
+
+	list, err := apiclient.GetApplicationList(ctx, r.client, id)
+	if removeMissingResource(ctx, resp, err, "Application list", strconv.FormatInt(id, 10)) {
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading Application List",
+			"Could not read application list ID "+strconv.FormatInt(id, 10)+": "+err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(mapApplicationListToModel(ctx, list, &state)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *ApplicationListResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan ApplicationListResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := plan.ID.ValueInt64()
+
+	payload, diags := r.applicationListPayload(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	list, err := apiclient.UpdateApplicationList(ctx, r.client, id, payload)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating Application List",
+			"Could not update application list ID "+strconv.FormatInt(id, 10)+": "+err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(mapApplicationListToModel(ctx, list, &plan)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *ApplicationListResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state ApplicationListResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := state.ID.ValueInt64()
+
+	err := apiclient.DeleteApplicationList(ctx, r.client, id)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error deleting Application List",
+			"Could not delete application list ID "+strconv.FormatInt(id, 10)+": "+err.Error(),
+		)
+		return
+	}
+}
+
+func (r *ApplicationListResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	id, err := strconv.ParseInt(req.ID, 10, 64)
+	if err != nil {
+		// Not a number, try to find by name
+		id, err = apiclient.GetApplicationListIDByName(ctx, r.client, req.ID)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error importing Application List",
+				fmt.Sprintf("Could not find application list with name %q: %s", req.ID, err),
+			)
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+}
+
+// applicationListPayload builds the request body for model. Application names
+// are resolved to IDs unless the plan already knows them, and the resolved IDs
+// are stored in application_name_ids.
+func (r *ApplicationListResource) applicationListPayload(ctx context.Context, model *ApplicationListResourceModel) (apiclient.ApplicationListPayload, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	payload := apiclient.ApplicationListPayload{
+		Name:                   model.Name.ValueString(),
+		IsDefault:              model.IsDefault.ValueBool(),
+		ApplicationIDs:         []int64{},
+		ApplicationCategoryIDs: []int64{},
+	}
+
+	if !model.ApplicationIDs.IsNull() {
+		diags.Append(model.ApplicationIDs.ElementsAs(ctx, &payload.ApplicationIDs, false)...)
+	}
+	if !model.ApplicationCategoryIDs.IsNull() {
+		diags.Append(model.ApplicationCategoryIDs.ElementsAs(ctx, &payload.ApplicationCategoryIDs, false)...)
+	}
+
+	nameIDs := map[string]int64{}
+	if !model.ApplicationNames.IsNull() {
+		if model.ApplicationNameIDs.IsUnknown() {
+			var names []string
+			diags.Append(model.ApplicationNames.ElementsAs(ctx, &names, false)...)
+			if diags.HasError() {
+				return payload, diags
+			}
+
+			apps, err := r.client.GetApplications(ctx)
+			if err != nil {
+				diags.AddError("Unable to Resolve Application Names", err.Error())
+				return payload, diags
+			}
+
+			var d diag.Diagnostics
+			nameIDs, d = applicationIDsByName(apps, names)
+			diags.Append(d...)
+			if diags.HasError() {
+				return payload, diags
+			}
+		} else {
+			diags.Append(model.ApplicationNameIDs.ElementsAs(ctx, &nameIDs, false)...)
+		}
+	}
+
+	for _, id := range nameIDs {
+		if !slices.Contains(payload.ApplicationIDs, id) {
+			payload.ApplicationIDs = append(payload.ApplicationIDs, id)
+		}
+	}
+	slices.Sort(payload.ApplicationIDs)
+
+	var d diag.Diagnostics
+	model.ApplicationNameIDs, d = int64MapOrNull(ctx, nameIDs)
+	diags.Append(d...)
+
+	return payload, diags
+}
+
+// mapApplicationListToModel sets model from list. The API only knows the
+// combined application IDs: IDs resolved from application_names are kept there,
+// all others are reported in application_ids. Names whose application was
+// removed outside of Terraform are dropped, so that the next apply adds them
+// again.
+func mapApplicationListToModel(ctx context.Context, list *apiclient.ApplicationList, model *ApplicationListResourceModel) diag.Diagnostics {
+	var diags, d diag.Diagnostics
+
+	model.ID = types.Int64Value(list.ID)
+	model.Name = types.StringValue(list.Name)
+	model.IsDefault = types.BoolValue(list.IsDefault)
+
+	configured := []int64{}
+	if !model.ApplicationIDs.IsNull() && !model.ApplicationIDs.IsUnknown() {
+		diags.Append(model.ApplicationIDs.ElementsAs(ctx, &configured, false)...)
+	}
+	nameIDs := map[string]int64{}
+	if !model.ApplicationNameIDs.IsNull() && !model.ApplicationNameIDs.IsUnknown() {
+		diags.Append(model.ApplicationNameIDs.ElementsAs(ctx, &nameIDs, false)...)
+	}
+	if diags.HasError() {
+		return diags
+	}
+
+	var names []string
+	byName := map[int64]bool{}
+	for name, id := range nameIDs {
+		if !slices.Contains(list.ApplicationIDs, id) {
+			delete(nameIDs, name)
+			continue
+		}
+		names = append(names, name)
+		byName[id] = true
+	}
+
+	ids := []int64{}
+	for _, id := range list.ApplicationIDs {
+		if slices.Contains(configured, id) || !byName[id] {
+			ids = append(ids, id)
+		}
+	}
+
+	model.ApplicationIDs, d = int64SetOrNull(ctx, ids)
+	diags.Append(d...)
+	model.ApplicationCategoryIDs, d = int64SetOrNull(ctx, list.ApplicationCategoryIDs)
+	diags.Append(d...)
+	model.ApplicationNameIDs, d = int64MapOrNull(ctx, nameIDs)
+	diags.Append(d...)
+
+	if len(names) == 0 {
+		model.ApplicationNames = types.SetNull(types.StringType)
+	} else {
+		model.ApplicationNames, d = types.SetValueFrom(ctx, types.StringType, names)
+		diags.Append(d...)
+	}
+
+	return diags
+}
+
+// applicationIDsByName returns the IDs of the applications labelled names. A
+// name no application has, or that several applications share, is an error.
+func applicationIDsByName(apps []apiclient.Application, names []string) (map[string]int64, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	ids := map[string]int64{}
+	var missing, ambiguous []string
+	for _, name := range names {
+		var matches []int64
+		for _, app := range apps {
+			if app.Label == name && !slices.Contains(matches, int64(app.ID)) {
+				matches = append(matches, int64(app.ID))
+			}
+		}
+		switch len(matches) {
+		case 0:
+			missing = append(missing, strconv.Quote(name))
+		case 1:
+			ids[name] = matches[0]
+		default:
+			ambiguous = append(ambiguous, strconv.Quote(name))
+		}
+	}
+
+	if len(missing) > 0 {
+		diags.AddAttributeError(
+			path.Root("application_names"),
+			"Unknown Application Names",
+			fmt.Sprintf("No application found with name %s.", strings.Join(missing, ", ")),
+		)
+	}
+	if len(ambiguous) > 0 {
+		diags.AddAttributeError(
+			path.Root("application_names"),
+			"Ambiguous Application Names",
+			fmt.Sprintf("Several applications are named %s. Use application_ids for them instead.", strings.Join(ambiguous, ", ")),
+		)
+	}
+
+	return ids, diags
+}
+
+// int64MapOrNull maps an empty map of IDs to a null map.
+func int64MapOrNull(ctx context.Context, ids map[string]int64) (types.Map, diag.Diagnostics) {
+	if len(ids) == 0 {
+		return types.MapNull(types.Int64Type), nil
+	}
+	return types.MapValueFrom(ctx, types.Int64Type, ids)
+}
+
+func stringValues(values []types.String) []string {
+	s := make([]string, 0, len(values))
+	for _, v := range values {
+		s = append(s, v.ValueString())
+	}
+	return s
+}
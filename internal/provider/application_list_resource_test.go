@@ -0,0 +1,88 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccApplicationListResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccApplicationListResourceConfig("tf-acc-test-app-list", false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("sse_application_list.test", "name", "tf-acc-test-app-list"),
+					resource.TestCheckResourceAttr("sse_application_list.test", "is_default", "false"),
+					resource.TestCheckResourceAttr("sse_application_list.test", "application_ids.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair("sse_application_list.test", "application_ids.*", "data.sse_application.dropbox", "id"),
+					resource.TestCheckNoResourceAttr("sse_application_list.test", "application_names"),
+					resource.TestCheckResourceAttrSet("sse_application_list.test", "id"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "sse_application_list.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// ImportState testing by name
+			{
+				ResourceName:      "sse_application_list.test",
+				ImportState:       true,
+				ImportStateId:     "tf-acc-test-app-list",
+				ImportStateVerify: true,
+			},
+			// Update testing with application names
+			{
+				Config: testAccApplicationListResourceConfig("tf-acc-test-app-list-updated", true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("sse_application_list.test", "name", "tf-acc-test-app-list-updated"),
+					resource.TestCheckResourceAttr("sse_application_list.test", "application_ids.#", "1"),
+					resource.TestCheckResourceAttr("sse_application_list.test", "application_names.#", "1"),
+					resource.TestCheckTypeSetElemAttr("sse_application_list.test", "application_names.*", "Box"),
+					resource.TestCheckResourceAttrSet("sse_application_list.test", "application_name_ids.Box"),
+				),
+			},
+			// Data source
+			{
+				Config: testAccApplicationListResourceConfig("tf-acc-test-app-list-updated", true) + `
+data "sse_application_list" "test" {
+  name = sse_application_list.test.name
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.sse_application_list.test", "id", "sse_application_list.test", "id"),
+					resource.TestCheckResourceAttr("data.sse_application_list.test", "application_ids.#", "2"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccApplicationListResourceConfig(name string, withNames bool) string {
+	names := ""
+	if withNames {
+		names = `application_names = ["Box"]`
+	}
+
+	return fmt.Sprintf(`
+data "sse_application" "dropbox" {
+  name = "Dropbox"
+}
+
+resource "sse_application_list" "test" {
+  name            = %[1]q
+  application_ids = [data.sse_application.dropbox.id]
+  %[2]s
+}
+`, name, names)
+}
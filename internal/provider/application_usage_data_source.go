@@ -0,0 +1,311 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/cisco/terraform-provider-sse/internal/apiclient"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &ApplicationUsageDataSource{}
+var _ datasource.DataSourceWithValidateConfig = &ApplicationUsageDataSource{}
+
+func NewApplicationUsageDataSource() datasource.DataSource {
+	return &ApplicationUsageDataSource{}
+}
+
+type ApplicationUsageDataSource struct {
+	client *apiclient.APIClient
+}
+
+type ApplicationUsageDataSourceModel struct {
+	IdentityID    types.Int64             `tfsdk:"identity_id"`
+	Date          types.String            `tfsdk:"date"`
+	Sources       []types.String          `tfsdk:"sources"`
+	Labels        []types.String          `tfsdk:"labels"`
+	WeightedRisks []types.String          `tfsdk:"weighted_risks"`
+	Categories    []types.String          `tfsdk:"categories"`
+	AppTypes      []types.String          `tfsdk:"app_types"`
+	Applications  []ApplicationUsageModel `tfsdk:"applications"`
+	Names         []types.String          `tfsdk:"names"`
+}
+
+type ApplicationUsageModel struct {
+	ID                 types.String `tfsdk:"id"`
+	Name               types.String `tfsdk:"name"`
+	Label              types.String `tfsdk:"label"`
+	WeightedRisk       types.String `tfsdk:"weighted_risk"`
+	Category           types.String `tfsdk:"category"`
+	AppType            types.String `tfsdk:"app_type"`
+	FirstDetected      types.String `tfsdk:"first_detected"`
+	LastDetected       types.String `tfsdk:"last_detected"`
+	DNSRequests        types.Int64  `tfsdk:"dns_requests"`
+	DNSBlockedRequests types.Int64  `tfsdk:"dns_blocked_requests"`
+	SWGBytesIn         types.Int64  `tfsdk:"swg_bytes_in"`
+	SWGBytesOut        types.Int64  `tfsdk:"swg_bytes_out"`
+	SWGBlockedBytesOut types.Int64  `tfsdk:"swg_blocked_bytes_out"`
+	CDFWEvents         types.Int64  `tfsdk:"cdfw_events"`
+	CDFWBlockedEvents  types.Int64  `tfsdk:"cdfw_blocked_events"`
+}
+
+// applicationUsageFilterValues are the valid values of the list filters.
+var applicationUsageFilterValues = map[string][]string{
+	"sources":        {"dns", "swg", "cdfw"},
+	"labels":         {"unreviewed", "approved", "notApproved", "underAudit"},
+	"weighted_risks": {"veryLow", "low", "medium", "high", "veryHigh"},
+	"app_types":      {"saas", "paas", "iaas"},
+}
+
+func (d *ApplicationUsageDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_application_usage"
+}
+
+func (d *ApplicationUsageDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Fetches the applications seen in the traffic of the organization from the App Discovery API, with their request counts and detection times. App Discovery has its own application catalog with string IDs, which differs from the Reporting API catalog used by `sse_application` and `sse_application_list`, so the names are not guaranteed to resolve in `sse_application_list.application_names`.",
+		Attributes: map[string]schema.Attribute{
+			"identity_id": schema.Int64Attribute{
+				Optional:    true,
+				Description: "Only report the traffic of this identity. Use the `id` attribute of `sse_identity`.",
+			},
+			"date": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only report the traffic of this day (`YYYY-MM-DD`). By default the traffic of the last 90 days is reported.",
+			},
+			"sources": schema.ListAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: "Only report applications seen in these log sources (`dns`, `swg` or `cdfw`).",
+			},
+			"labels": schema.ListAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: "Only report applications with these labels (`unreviewed`, `approved`, `notApproved` or `underAudit`).",
+			},
+			"weighted_risks": schema.ListAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: "Only report applications with these weighted risks (`veryLow`, `low`, `medium`, `high` or `veryHigh`).",
+			},
+			"categories": schema.ListAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: "Only report applications in these categories, such as `Security`.",
+			},
+			"app_types": schema.ListAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: "Only report applications of these types (`saas`, `paas` or `iaas`).",
+			},
+			"applications": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "The applications seen in traffic.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:    true,
+							Description: "The App Discovery ID of the application.",
+						},
+						"name": schema.StringAttribute{
+							Computed:    true,
+							Description: "The name of the application in the App Discovery catalog.",
+						},
+						"label": schema.StringAttribute{
+							Computed:    true,
+							Description: "The label of the application (`unreviewed`, `approved`, `notApproved` or `underAudit`).",
+						},
+						"weighted_risk": schema.StringAttribute{
+							Computed:    true,
+							Description: "The weighted risk of the application.",
+						},
+						"category": schema.StringAttribute{
+							Computed:    true,
+							Description: "The category of the application.",
+						},
+						"app_type": schema.StringAttribute{
+							Computed:    true,
+							Description: "The type of the application (`saas`, `paas` or `iaas`).",
+						},
+						"first_detected": schema.StringAttribute{
+							Computed:    true,
+							Description: "When the application was first seen (RFC 3339).",
+						},
+						"last_detected": schema.StringAttribute{
+							Computed:    true,
+							Description: "When the application was last seen (RFC 3339).",
+						},
+						"dns_requests": schema.Int64Attribute{
+							Computed:    true,
+							Description: "The number of DNS requests for the application.",
+						},
+						"dns_blocked_requests": schema.Int64Attribute{
+							Computed:    true,
+							Description: "The number of DNS requests for the application that were blocked.",
+						},
+						"swg_bytes_in": schema.Int64Attribute{
+							Computed:    true,
+							Description: "The number of bytes received from the application through the Secure Web Gateway.",
+						},
+						"swg_bytes_out": schema.Int64Attribute{
+							Computed:    true,
+							Description: "The number of bytes sent to the application through the Secure Web Gateway.",
+						},
+						"swg_blocked_bytes_out": schema.Int64Attribute{
+							Computed:    true,
+							Description: "The number of outbound bytes to the application that were blocked.",
+						},
+						"cdfw_events": schema.Int64Attribute{
+							Computed:    true,
+							Description: "The number of cloud-delivered firewall L7 events for the application.",
+						},
+						"cdfw_blocked_events": schema.Int64Attribute{
+							Computed:    true,
+							Description: "The number of cloud-delivered firewall L7 events for the application that were blocked.",
+						},
+					},
+				},
+			},
+			"names": schema.ListAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+				Description: "The names of the applications seen in traffic, in the App Discovery catalog.",
+			},
+		},
+	}
+}
+
+func (d *ApplicationUsageDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	var config ApplicationUsageDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !config.Date.IsNull() && !config.Date.IsUnknown() {
+		if _, err := time.Parse(time.DateOnly, config.Date.ValueString()); err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("date"),
+				"Invalid Date",
+				fmt.Sprintf("The date must have the format YYYY-MM-DD, got %q.", config.Date.ValueString()),
+			)
+		}
+	}
+
+	for name, values := range map[string][]types.String{
+		"sources":        config.Sources,
+		"labels":         config.Labels,
+		"weighted_risks": config.WeightedRisks,
+		"app_types":      config.AppTypes,
+	} {
+		valid := applicationUsageFilterValues[name]
+		for i, v := range values {
+			if v.IsNull() || v.IsUnknown() || slices.Contains(valid, v.ValueString()) {
+				continue
+			}
+			resp.Diagnostics.AddAttributeError(
+				path.Root(name).AtListIndex(i),
+				"Invalid Application Usage Filter",
+				fmt.Sprintf("%q is not valid in %s. Valid values are: %s.", v.ValueString(), name, strings.Join(valid, ", ")),
+			)
+		}
+	}
+}
+
+func (d *ApplicationUsageDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*apiclient.APIClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *apiclient.APIClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *ApplicationUsageDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state ApplicationUsageDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apps, err := apiclient.GetApplicationUsage(ctx, d.client, apiclient.ApplicationUsageFilters{
+		IdentityID:    state.IdentityID.ValueInt64(),
+		Date:          state.Date.ValueString(),
+		Sources:       stringValues(state.Sources),
+		Labels:        stringValues(state.Labels),
+		WeightedRisks: stringValues(state.WeightedRisks),
+		Categories:    stringValues(state.Categories),
+		AppTypes:      stringValues(state.AppTypes),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Application Usage",
+			err.Error(),
+		)
+		return
+	}
+
+	state.Applications = []ApplicationUsageModel{}
+	state.Names = []types.String{}
+	for _, app := range apps {
+		model := ApplicationUsageModel{
+			ID:            types.StringValue(app.ID),
+			Name:          types.StringValue(app.Name),
+			Label:         types.StringValue(app.Label),
+			WeightedRisk:  types.StringValue(app.WeightedRisk),
+			Category:      types.StringValue(app.Category),
+			AppType:       types.StringValue(app.AppType),
+			FirstDetected: types.StringValue(app.FirstDetected),
+			LastDetected:  types.StringValue(app.LastDetected),
+		}
+
+		// Only the counters that belong to a source are read from it: the API
+		// may fill in other fields, e.g. events for dns.
+		var usage apiclient.ApplicationUsageSource
+		for _, source := range app.Sources {
+			switch source.Name {
+			case "dns":
+				usage.Requests += source.Requests
+				usage.BlockedRequests += source.BlockedRequests
+			case "swg":
+				usage.BytesIn += source.BytesIn
+				usage.BytesOut += source.BytesOut
+				usage.BlockedBytesOut += source.BlockedBytesOut
+			case "cdfw":
+				usage.Events += source.Events
+				usage.BlockedEvents += source.BlockedEvents
+			}
+		}
+		model.DNSRequests = types.Int64Value(usage.Requests)
+		model.DNSBlockedRequests = types.Int64Value(usage.BlockedRequests)
+		model.SWGBytesIn = types.Int64Value(usage.BytesIn)
+		model.SWGBytesOut = types.Int64Value(usage.BytesOut)
+		model.SWGBlockedBytesOut = types.Int64Value(usage.BlockedBytesOut)
+		model.CDFWEvents = types.Int64Value(usage.Events)
+		model.CDFWBlockedEvents = types.Int64Value(usage.BlockedEvents)
+
+		state.Applications = append(state.Applications, model)
+		state.Names = append(state.Names, types.StringValue(app.Name))
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
@@ -85,7 +85,7 @@ func (d *ApplicationsDataSource) Configure(ctx context.Context, req datasource.C
 func (d *ApplicationsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
 	var state ApplicationsDataSourceModel
 
-	apps, err := d.client.GetApplications()
+	apps, err := d.client.GetApplications(ctx)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Unable to Read Applications",
@@ -129,7 +129,7 @@ func (r *ConnectorGroupResource) Create(ctx context.Context, req resource.Create
 	}
 
 	// Call API
-	group, err := r.client.CreateConnectorGroup(apiReq)
+	group, err := r.client.CreateConnectorGroup(ctx, apiReq)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error creating Connector Group",
@@ -170,7 +170,10 @@ func (r *ConnectorGroupResource) Read(ctx context.Context, req resource.ReadRequ
 	id := int(state.ID.ValueInt64())
 
 	// Call API
-	group, err := r.client.GetConnectorGroup(id)
+	group, err := r.client.GetConnectorGroup(ctx, id)
+	if removeMissingResource(ctx, resp, err, "Connector group", strconv.Itoa(id)) {
+		return
+	}
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error reading Connector Group",
@@ -179,11 +182,6 @@ func (r *ConnectorGroupResource) Read(ctx context.Context, req resource.ReadRequ
 		return
 	}
 
-	if group == nil {
-		resp.State.RemoveResource(ctx)
-		return
-	}
-
 	// Map response to model
 	state.Name = types.StringValue(group.Name)
 	state.Location = types.StringValue(group.Location)
@@ -221,7 +219,7 @@ func (r *ConnectorGroupResource) Update(ctx context.Context, req resource.Update
 	}
 
 	// Call API
-	group, err := r.client.UpdateConnectorGroup(id, apiReq)
+	group, err := r.client.UpdateConnectorGroup(ctx, id, apiReq)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error updating Connector Group",
@@ -262,7 +260,7 @@ func (r *ConnectorGroupResource) Delete(ctx context.Context, req resource.Delete
 	id := int(state.ID.ValueInt64())
 
 	// Call API
-	err := r.client.DeleteConnectorGroup(id)
+	err := r.client.DeleteConnectorGroup(ctx, id)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error deleting Connector Group",
@@ -277,7 +275,7 @@ func (r *ConnectorGroupResource) ImportState(ctx context.Context, req resource.I
 	id, err := strconv.ParseInt(req.ID, 10, 64)
 	if err != nil {
 		// If it's not an integer, assume it's a name and try to look it up
-		group, err := r.client.GetConnectorGroupByName(req.ID)
+		group, err := r.client.GetConnectorGroupByName(ctx, req.ID)
 		if err != nil {
 			resp.Diagnostics.AddError(
 				"Error importing Connector Group by name",
@@ -101,28 +101,13 @@ func (d *ConnectorGroupsDataSource) Configure(ctx context.Context, req datasourc
 func (d *ConnectorGroupsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
 	var state ConnectorGroupsDataSourceModel
 
-	// Fetch all connector groups
-	// The API supports pagination, so we need to loop until we get all of them.
-	var allGroups []apiclient.ConnectorGroup
-	limit := 100
-	offset := 0
-
-	for {
-		groups, err := d.client.GetConnectorGroups(limit, offset)
-		if err != nil {
-			resp.Diagnostics.AddError(
-				"Unable to Read Connector Groups",
-				err.Error(),
-			)
-			return
-		}
-
-		allGroups = append(allGroups, groups...)
-
-		if len(groups) < limit {
-			break
-		}
-		offset += limit
+	allGroups, err := d.client.GetConnectorGroups(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Connector Groups",
+			err.Error(),
+		)
+		return
 	}
 
 	// Map response body to model
@@ -38,7 +38,7 @@ func (d *ContentCategoryListsDataSource) Metadata(ctx context.Context, req datas
 
 func (d *ContentCategoryListsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		Description: "Fetches the list of Content Category Lists.",
+		Description: "Fetches the list of Content Category Lists. The Content Categories API is read-only, so the lists are managed in the Secure Access dashboard and referenced by ID in access rules.",
 		Attributes: map[string]schema.Attribute{
 			"content_category_lists": schema.ListNestedAttribute{
 				Computed: true,
@@ -77,7 +77,7 @@ func (d *ContentCategoryListsDataSource) Configure(ctx context.Context, req data
 func (d *ContentCategoryListsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
 	var state ContentCategoryListsDataSourceModel
 
-	categories, err := d.client.GetContentCategories()
+	categories, err := d.client.GetContentCategories(ctx)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Unable to Read Content Category Lists",
@@ -186,7 +186,7 @@ func (r *DestinationListResource) Create(ctx context.Context, req resource.Creat
 		payload.BundleTypeID = 1
 	}
 
-	list, err := apiclient.PostDestinationList(r.client, payload)
+	list, err := apiclient.PostDestinationList(ctx, r.client, payload)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error creating destination list",
@@ -222,14 +222,14 @@ func (r *DestinationListResource) Create(ctx context.Context, req resource.Creat
 			})
 		}
 
-		err := apiclient.PostDestinations(r.client, list.ID, destinations)
+		err := apiclient.PostDestinations(ctx, r.client, list.ID, destinations)
 		if err != nil {
 			resp.Diagnostics.AddError(
 				"Error adding destinations",
 				"Could not add destinations to list, unexpected error: "+err.Error(),
 			)
 			// Try to cleanup
-			_ = apiclient.DeleteDestinationList(r.client, list.ID)
+			_ = apiclient.DeleteDestinationList(ctx, r.client, list.ID)
 			return
 		}
 	}
@@ -239,13 +239,19 @@ func (r *DestinationListResource) Create(ctx context.Context, req resource.Creat
 		var err error
 
 		// Retry loop to handle eventual consistency
-		// Increased to 30 seconds as API can be slow to index
+		// Increased to 30 seconds as API can be slow to index.
+		// Stop early on cancellation and fall back to plan data below.
+	waitForDestinations:
 		for i := 0; i < 30; i++ {
-			dests, err = apiclient.GetDestinationsDetails(r.client, list.ID)
+			dests, err = apiclient.GetDestinationsDetails(ctx, r.client, list.ID)
 			if err == nil && len(dests) >= len(planDestinations) {
 				break
 			}
-			time.Sleep(1 * time.Second)
+			select {
+			case <-ctx.Done():
+				break waitForDestinations
+			case <-time.After(1 * time.Second):
+			}
 		}
 
 		if err != nil || len(dests) < len(planDestinations) {
@@ -300,7 +306,10 @@ func (r *DestinationListResource) Read(ctx context.Context, req resource.ReadReq
 		return
 	}
 
-	list, err := apiclient.GetDestinationListDetails(r.client, id)
+	list, err := apiclient.GetDestinationListDetails(ctx, r.client, id)
+	if removeMissingResource(ctx, resp, err, "Destination list", data.ID.ValueString()) {
+		return
+	}
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error reading destination list",
@@ -322,7 +331,7 @@ func (r *DestinationListResource) Read(ctx context.Context, req resource.ReadReq
 	// data.MarkedForDeletion = types.BoolValue(list.MarkedForDeletion)
 
 	// Read destinations
-	dests, err := apiclient.GetDestinationsDetails(r.client, id)
+	dests, err := apiclient.GetDestinationsDetails(ctx, r.client, id)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error reading destinations",
@@ -364,7 +373,7 @@ func (r *DestinationListResource) Update(ctx context.Context, req resource.Updat
 		payload := apiclient.UpdateDestinationListPayload{
 			Name: data.Name.ValueString(),
 		}
-		_, err := apiclient.PatchDestinationList(r.client, id, payload)
+		_, err := apiclient.PatchDestinationList(ctx, r.client, id, payload)
 		if err != nil {
 			resp.Diagnostics.AddError(
 				"Error updating destination list",
@@ -469,7 +478,7 @@ func (r *DestinationListResource) Update(ctx context.Context, req resource.Updat
 	}
 
 	if len(toDeleteIDs) > 0 {
-		err := apiclient.DeleteDestinations(r.client, id, toDeleteIDs)
+		err := apiclient.DeleteDestinations(ctx, r.client, id, toDeleteIDs)
 		if err != nil {
 			resp.Diagnostics.AddError("Error deleting destinations", err.Error())
 			return
@@ -477,7 +486,7 @@ func (r *DestinationListResource) Update(ctx context.Context, req resource.Updat
 	}
 
 	if len(toAdd) > 0 {
-		err := apiclient.PostDestinations(r.client, id, toAdd)
+		err := apiclient.PostDestinations(ctx, r.client, id, toAdd)
 		if err != nil {
 			resp.Diagnostics.AddError("Error adding destinations", err.Error())
 			return
@@ -486,7 +495,7 @@ func (r *DestinationListResource) Update(ctx context.Context, req resource.Updat
 
 	// Refresh state
 	// Read back everything
-	list, err := apiclient.GetDestinationListDetails(r.client, id)
+	list, err := apiclient.GetDestinationListDetails(ctx, r.client, id)
 	if err != nil {
 		resp.Diagnostics.AddError("Error reading updated destination list", err.Error())
 		return
@@ -495,7 +504,7 @@ func (r *DestinationListResource) Update(ctx context.Context, req resource.Updat
 
 	// data.ModifiedAt = types.Int64Value(list.ModifiedAt)
 
-	dests, err := apiclient.GetDestinationsDetails(r.client, id)
+	dests, err := apiclient.GetDestinationsDetails(ctx, r.client, id)
 	if err != nil {
 		resp.Diagnostics.AddError("Error reading updated destinations", err.Error())
 		return
@@ -522,7 +531,7 @@ func (r *DestinationListResource) Delete(ctx context.Context, req resource.Delet
 		return
 	}
 
-	err = apiclient.DeleteDestinationList(r.client, id)
+	err = apiclient.DeleteDestinationList(ctx, r.client, id)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error deleting destination list",
@@ -536,7 +545,7 @@ func (r *DestinationListResource) ImportState(ctx context.Context, req resource.
 	id := req.ID
 	if _, err := strconv.ParseInt(id, 10, 64); err != nil {
 		// Not a number, try to find by name
-		foundID, err := apiclient.GetDestinationListIDByName(r.client, id)
+		foundID, err := apiclient.GetDestinationListIDByName(ctx, r.client, id)
 		if err != nil {
 			resp.Diagnostics.AddError(
 				"Error importing destination list",
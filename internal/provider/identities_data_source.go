@@ -5,9 +5,7 @@ package provider
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
-	"io"
 
 	"github.com/cisco/terraform-provider-sse/internal/apiclient"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
@@ -100,8 +98,7 @@ func (d *IdentitiesDataSource) Read(ctx context.Context, req datasource.ReadRequ
 	var data IdentitiesDataSourceModel
 
 	// Read API call logic
-	endpoint := "identities?limit=100&offset=0"
-	httpResp, err := d.client.Query(apiclient.ScopeReports, endpoint, apiclient.OperationGet, nil)
+	identities, err := d.client.GetIdentities(ctx)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Client Error",
@@ -109,54 +106,9 @@ func (d *IdentitiesDataSource) Read(ctx context.Context, req datasource.ReadRequ
 		)
 		return
 	}
-	defer httpResp.Body.Close()
-
-	body, err := io.ReadAll(httpResp.Body)
-	if err != nil {
-		resp.Diagnostics.AddError(
-			"Client Error",
-			fmt.Sprintf("Unable to read response body, got error: %s", err),
-		)
-		return
-	}
-
-	if httpResp.StatusCode != 200 {
-		resp.Diagnostics.AddError(
-			"Client Error",
-			fmt.Sprintf("Unable to read identities, got status code: %d, body: %s", httpResp.StatusCode, string(body)),
-		)
-		return
-	}
-
-	// Define struct to match API response
-	type IdentityType struct {
-		ID    int    `json:"id"`
-		Type  string `json:"type"`
-		Label string `json:"label"`
-	}
-
-	type Identity struct {
-		ID      int64        `json:"id"`
-		Label   string       `json:"label"`
-		Type    IdentityType `json:"type"`
-		Deleted bool         `json:"deleted"`
-	}
-
-	type IdentityList struct {
-		Data []Identity `json:"data"`
-	}
-
-	var identityList IdentityList
-	if err := json.Unmarshal(body, &identityList); err != nil {
-		resp.Diagnostics.AddError(
-			"Client Error",
-			fmt.Sprintf("Unable to unmarshal response body, got error: %s", err),
-		)
-		return
-	}
 
 	// Map response to state
-	for _, identity := range identityList.Data {
+	for _, identity := range identities {
 		data.Identities = append(data.Identities, IdentityModel{
 			ID:      types.Int64Value(identity.ID),
 			Label:   types.StringValue(identity.Label),
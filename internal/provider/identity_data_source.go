@@ -86,7 +86,7 @@ func (d *IdentityDataSource) Read(ctx context.Context, req datasource.ReadReques
 
 	targetName := state.Name.ValueString()
 
-	identities, err := d.client.GetIdentities()
+	identities, err := d.client.GetIdentities(ctx)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Unable to Read Identities",
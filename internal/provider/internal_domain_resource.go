@@ -0,0 +1,282 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/cisco/terraform-provider-sse/internal/apiclient"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ resource.Resource = &InternalDomainResource{}
+var _ resource.ResourceWithImportState = &InternalDomainResource{}
+var _ resource.ResourceWithValidateConfig = &InternalDomainResource{}
+
+func NewInternalDomainResource() resource.Resource {
+	return &InternalDomainResource{}
+}
+
+type InternalDomainResource struct {
+	client *apiclient.APIClient
+}
+
+type InternalDomainResourceModel struct {
+	ID                      types.Int64  `tfsdk:"id"`
+	Domain                  types.String `tfsdk:"domain"`
+	Description             types.String `tfsdk:"description"`
+	IncludeAllVAs           types.Bool   `tfsdk:"include_all_vas"`
+	IncludeAllMobileDevices types.Bool   `tfsdk:"include_all_mobile_devices"`
+	SiteIDs                 types.Set    `tfsdk:"site_ids"`
+}
+
+func (r *InternalDomainResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_internal_domain"
+}
+
+func (r *InternalDomainResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages an Internal Domain. DNS queries for internal domains are resolved by the internal DNS servers instead of Secure Access.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				Computed:    true,
+				Description: "The ID of the Internal Domain.",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"domain": schema.StringAttribute{
+				Required:    true,
+				Description: "The internal domain name (e.g., corp.example.com).",
+			},
+			"description": schema.StringAttribute{
+				Optional:    true,
+				Description: "The description of the Internal Domain (1-50 characters).",
+			},
+			"include_all_vas": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+				Description: "Whether the Internal Domain applies to all virtual appliances. Defaults to `false`.",
+			},
+			"include_all_mobile_devices": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+				Description: "Whether the Internal Domain applies to all mobile devices. Defaults to `false`.",
+			},
+			"site_ids": schema.SetAttribute{
+				Optional:    true,
+				ElementType: types.Int64Type,
+				Description: "The IDs of the sites the Internal Domain applies to. If not set, the Internal Domain applies to all sites. Must not be empty.",
+			},
+		},
+	}
+}
+
+func (r *InternalDomainResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var siteIDs types.Set
+
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("site_ids"), &siteIDs)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The API treats an empty list as all sites and returns it the same way
+	// as an unset one.
+	if !siteIDs.IsNull() && !siteIDs.IsUnknown() && len(siteIDs.Elements()) == 0 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("site_ids"),
+			"Empty Site IDs",
+			"site_ids must not be empty. Omit the attribute instead to apply the Internal Domain to all sites.",
+		)
+	}
+}
+
+func (r *InternalDomainResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*apiclient.APIClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *apiclient.APIClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *InternalDomainResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan InternalDomainResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	payload, diags := internalDomainPayload(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	internalDomain, err := apiclient.CreateInternalDomain(ctx, r.client, payload)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating Internal Domain",
+			"Could not create internal domain, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(mapInternalDomainToModel(ctx, internalDomain, &plan)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *InternalDomainResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state InternalDomainResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := state.ID.ValueInt64()
+
+	internalDomain, err := apiclient.GetInternalDomain(ctx, r.client, id)
+	if removeMissingResource(ctx, resp, err, "Internal domain", strconv.FormatInt(id, 10)) {
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading Internal Domain",
+			"Could not read internal domain ID "+strconv.FormatInt(id, 10)+": "+err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(mapInternalDomainToModel(ctx, internalDomain, &state)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *InternalDomainResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan InternalDomainResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := plan.ID.ValueInt64()
+
+	payload, diags := internalDomainPayload(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	internalDomain, err := apiclient.UpdateInternalDomain(ctx, r.client, id, payload)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating Internal Domain",
+			"Could not update internal domain ID "+strconv.FormatInt(id, 10)+": "+err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(mapInternalDomainToModel(ctx, internalDomain, &plan)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *InternalDomainResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state InternalDomainResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := state.ID.ValueInt64()
+
+	err := apiclient.DeleteInternalDomain(ctx, r.client, id)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error deleting Internal Domain",
+			"Could not delete internal domain ID "+strconv.FormatInt(id, 10)+": "+err.Error(),
+		)
+		return
+	}
+}
+
+func (r *InternalDomainResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	id, err := strconv.ParseInt(req.ID, 10, 64)
+	if err != nil {
+		// Not a number, try to find by domain name
+		id, err = apiclient.GetInternalDomainIDByDomain(ctx, r.client, req.ID)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error importing Internal Domain",
+				fmt.Sprintf("Could not find internal domain %q: %s", req.ID, err),
+			)
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+}
+
+func internalDomainPayload(ctx context.Context, model InternalDomainResourceModel) (apiclient.InternalDomainPayload, diag.Diagnostics) {
+	payload := apiclient.InternalDomainPayload{
+		Domain:                  model.Domain.ValueString(),
+		Description:             model.Description.ValueString(),
+		IncludeAllVAs:           model.IncludeAllVAs.ValueBool(),
+		IncludeAllMobileDevices: model.IncludeAllMobileDevices.ValueBool(),
+	}
+
+	var diags diag.Diagnostics
+	if !model.SiteIDs.IsNull() && !model.SiteIDs.IsUnknown() {
+		diags = model.SiteIDs.ElementsAs(ctx, &payload.SiteIDs, false)
+	}
+
+	return payload, diags
+}
+
+func mapInternalDomainToModel(ctx context.Context, internalDomain *apiclient.InternalDomain, model *InternalDomainResourceModel) diag.Diagnostics {
+	model.ID = types.Int64Value(internalDomain.ID)
+	model.Domain = types.StringValue(internalDomain.Domain)
+	model.Description = stringOrNull(internalDomain.Description)
+	model.IncludeAllVAs = types.BoolValue(internalDomain.IncludeAllVAs)
+	model.IncludeAllMobileDevices = types.BoolValue(internalDomain.IncludeAllMobileDevices)
+
+	// An empty list means all sites, which is how an unset site_ids is modelled.
+	if len(internalDomain.SiteIDs) == 0 {
+		model.SiteIDs = types.SetNull(types.Int64Type)
+		return nil
+	}
+
+	var diags diag.Diagnostics
+	model.SiteIDs, diags = types.SetValueFrom(ctx, types.Int64Type, internalDomain.SiteIDs)
+	return diags
+}
+
+// stringOrNull maps the empty string the API returns for an unset optional value to null.
+func stringOrNull(v string) types.String {
+	if v == "" {
+		return types.StringNull()
+	}
+	return types.StringValue(v)
+}
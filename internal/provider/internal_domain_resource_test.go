@@ -0,0 +1,86 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccInternalDomainResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// An empty site_ids is rejected, it would be read back as unset
+			{
+				Config: `
+resource "sse_internal_domain" "test" {
+  domain   = "tf-acc.internal.example.com"
+  site_ids = []
+}
+`,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`Empty Site IDs`),
+			},
+			// Create and Read testing
+			{
+				Config: testAccInternalDomainResourceConfig("tf-acc.internal.example.com", "Terraform acceptance test", false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("sse_internal_domain.test", "domain", "tf-acc.internal.example.com"),
+					resource.TestCheckResourceAttr("sse_internal_domain.test", "description", "Terraform acceptance test"),
+					resource.TestCheckResourceAttr("sse_internal_domain.test", "include_all_vas", "false"),
+					resource.TestCheckResourceAttr("sse_internal_domain.test", "include_all_mobile_devices", "false"),
+					resource.TestCheckResourceAttrSet("sse_internal_domain.test", "id"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "sse_internal_domain.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// ImportState testing by domain name
+			{
+				ResourceName:      "sse_internal_domain.test",
+				ImportState:       true,
+				ImportStateId:     "tf-acc.internal.example.com",
+				ImportStateVerify: true,
+			},
+			// Update testing
+			{
+				Config: testAccInternalDomainResourceConfig("tf-acc.internal.example.com", "Updated description", true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("sse_internal_domain.test", "description", "Updated description"),
+					resource.TestCheckResourceAttr("sse_internal_domain.test", "include_all_mobile_devices", "true"),
+				),
+			},
+			// Plural data source
+			{
+				Config: testAccInternalDomainResourceConfig("tf-acc.internal.example.com", "Updated description", true) + `
+data "sse_internal_domains" "all" {
+  depends_on = [sse_internal_domain.test]
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.sse_internal_domains.all", "internal_domains.#"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccInternalDomainResourceConfig(domain, description string, includeAllMobileDevices bool) string {
+	return fmt.Sprintf(`
+resource "sse_internal_domain" "test" {
+  domain                     = %[1]q
+  description                = %[2]q
+  include_all_mobile_devices = %[3]t
+}
+`, domain, description, includeAllMobileDevices)
+}
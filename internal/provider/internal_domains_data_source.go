@@ -0,0 +1,129 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cisco/terraform-provider-sse/internal/apiclient"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &InternalDomainsDataSource{}
+
+func NewInternalDomainsDataSource() datasource.DataSource {
+	return &InternalDomainsDataSource{}
+}
+
+type InternalDomainsDataSource struct {
+	client *apiclient.APIClient
+}
+
+type InternalDomainsDataSourceModel struct {
+	InternalDomains []InternalDomainModel `tfsdk:"internal_domains"`
+}
+
+type InternalDomainModel struct {
+	ID                      types.Int64   `tfsdk:"id"`
+	Domain                  types.String  `tfsdk:"domain"`
+	Description             types.String  `tfsdk:"description"`
+	IncludeAllVAs           types.Bool    `tfsdk:"include_all_vas"`
+	IncludeAllMobileDevices types.Bool    `tfsdk:"include_all_mobile_devices"`
+	SiteIDs                 []types.Int64 `tfsdk:"site_ids"`
+}
+
+func (d *InternalDomainsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_internal_domains"
+}
+
+func (d *InternalDomainsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Fetches the list of Internal Domains.",
+		Attributes: map[string]schema.Attribute{
+			"internal_domains": schema.ListNestedAttribute{
+				Computed: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.Int64Attribute{
+							Computed:    true,
+							Description: "The ID of the internal domain.",
+						},
+						"domain": schema.StringAttribute{
+							Computed:    true,
+							Description: "The internal domain name.",
+						},
+						"description": schema.StringAttribute{
+							Computed:    true,
+							Description: "The description of the internal domain.",
+						},
+						"include_all_vas": schema.BoolAttribute{
+							Computed:    true,
+							Description: "Whether the internal domain applies to all virtual appliances.",
+						},
+						"include_all_mobile_devices": schema.BoolAttribute{
+							Computed:    true,
+							Description: "Whether the internal domain applies to all mobile devices.",
+						},
+						"site_ids": schema.ListAttribute{
+							Computed:    true,
+							ElementType: types.Int64Type,
+							Description: "The IDs of the sites the internal domain applies to. Empty when it applies to all sites.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *InternalDomainsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*apiclient.APIClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *apiclient.APIClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *InternalDomainsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state InternalDomainsDataSourceModel
+
+	internalDomains, err := apiclient.GetInternalDomains(ctx, d.client)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Internal Domains",
+			err.Error(),
+		)
+		return
+	}
+
+	for _, internalDomain := range internalDomains {
+		siteIDs := []types.Int64{}
+		for _, id := range internalDomain.SiteIDs {
+			siteIDs = append(siteIDs, types.Int64Value(id))
+		}
+
+		state.InternalDomains = append(state.InternalDomains, InternalDomainModel{
+			ID:                      types.Int64Value(internalDomain.ID),
+			Domain:                  types.StringValue(internalDomain.Domain),
+			Description:             types.StringValue(internalDomain.Description),
+			IncludeAllVAs:           types.BoolValue(internalDomain.IncludeAllVAs),
+			IncludeAllMobileDevices: types.BoolValue(internalDomain.IncludeAllMobileDevices),
+			SiteIDs:                 siteIDs,
+		})
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
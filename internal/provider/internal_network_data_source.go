@@ -0,0 +1,116 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cisco/terraform-provider-sse/internal/apiclient"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &InternalNetworkDataSource{}
+
+func NewInternalNetworkDataSource() datasource.DataSource {
+	return &InternalNetworkDataSource{}
+}
+
+type InternalNetworkDataSource struct {
+	client *apiclient.APIClient
+}
+
+func (d *InternalNetworkDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_internal_network"
+}
+
+func (d *InternalNetworkDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	attributes := internalNetworkDataSourceAttributes()
+	attributes["id"] = schema.Int64Attribute{
+		Optional:    true,
+		Computed:    true,
+		Description: "The origin ID of the internal network to find. Either `id` or `name` must be set.",
+	}
+	attributes["name"] = schema.StringAttribute{
+		Optional:    true,
+		Computed:    true,
+		Description: "The name of the internal network to find. Either `id` or `name` must be set.",
+	}
+
+	resp.Schema = schema.Schema{
+		Description: "Fetches a single Internal Network by ID or name.",
+		Attributes:  attributes,
+	}
+}
+
+func (d *InternalNetworkDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*apiclient.APIClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *apiclient.APIClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *InternalNetworkDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state InternalNetworkModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if state.ID.IsNull() && state.Name.IsNull() {
+		resp.Diagnostics.AddError(
+			"Missing Internal Network Identifier",
+			"Either id or name must be set to look up an internal network.",
+		)
+		return
+	}
+
+	id := state.ID.ValueInt64()
+	if state.ID.IsNull() {
+		var err error
+		id, err = apiclient.GetInternalNetworkIDByName(ctx, d.client, state.Name.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Internal Network Not Found",
+				err.Error(),
+			)
+			return
+		}
+	}
+
+	network, err := apiclient.GetInternalNetwork(ctx, d.client, id)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Internal Network",
+			err.Error(),
+		)
+		return
+	}
+
+	state.ID = types.Int64Value(network.OriginID)
+	state.Name = types.StringValue(network.Name)
+	state.IPAddress = types.StringValue(network.IPAddress)
+	state.PrefixLength = types.Int64Value(int64(network.PrefixLength))
+	state.SiteID = int64OrNull(network.SiteID)
+	state.SiteName = types.StringValue(network.SiteName)
+	state.NetworkID = int64OrNull(network.NetworkID)
+	state.NetworkName = types.StringValue(network.NetworkName)
+	state.TunnelID = int64OrNull(network.TunnelID)
+	state.TunnelName = types.StringValue(network.TunnelName)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
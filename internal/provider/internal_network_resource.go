@@ -0,0 +1,303 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/netip"
+	"strconv"
+
+	"github.com/cisco/terraform-provider-sse/internal/apiclient"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ resource.Resource = &InternalNetworkResource{}
+var _ resource.ResourceWithImportState = &InternalNetworkResource{}
+var _ resource.ResourceWithValidateConfig = &InternalNetworkResource{}
+
+func NewInternalNetworkResource() resource.Resource {
+	return &InternalNetworkResource{}
+}
+
+type InternalNetworkResource struct {
+	client *apiclient.APIClient
+}
+
+type InternalNetworkResourceModel struct {
+	ID           types.Int64  `tfsdk:"id"`
+	Name         types.String `tfsdk:"name"`
+	IPAddress    types.String `tfsdk:"ip_address"`
+	PrefixLength types.Int64  `tfsdk:"prefix_length"`
+	SiteID       types.Int64  `tfsdk:"site_id"`
+	SiteName     types.String `tfsdk:"site_name"`
+	NetworkID    types.Int64  `tfsdk:"network_id"`
+	NetworkName  types.String `tfsdk:"network_name"`
+	TunnelID     types.Int64  `tfsdk:"tunnel_id"`
+	TunnelName   types.String `tfsdk:"tunnel_name"`
+}
+
+func (r *InternalNetworkResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_internal_network"
+}
+
+func (r *InternalNetworkResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages an Internal Network. An internal network is attached to exactly one of a site, a network or a network tunnel group.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				Computed:    true,
+				Description: "The origin ID of the Internal Network.",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:    true,
+				Description: "The name of the Internal Network (1-50 characters).",
+			},
+			"ip_address": schema.StringAttribute{
+				Required:    true,
+				Description: "The IP address of the Internal Network (e.g., 10.10.0.0).",
+			},
+			"prefix_length": schema.Int64Attribute{
+				Required:    true,
+				Description: "The prefix length of the Internal Network, between 8 and 32.",
+			},
+			"site_id": schema.Int64Attribute{
+				Optional:    true,
+				Description: "The ID of the site the Internal Network is attached to. Conflicts with `network_id` and `tunnel_id`.",
+			},
+			"site_name": schema.StringAttribute{
+				Computed:    true,
+				Description: "The name of the site the Internal Network is attached to.",
+			},
+			"network_id": schema.Int64Attribute{
+				Optional:    true,
+				Description: "The ID of the network the Internal Network is attached to. Conflicts with `site_id` and `tunnel_id`.",
+			},
+			"network_name": schema.StringAttribute{
+				Computed:    true,
+				Description: "The name of the network the Internal Network is attached to.",
+			},
+			"tunnel_id": schema.Int64Attribute{
+				Optional:    true,
+				Description: "The ID of the Network Tunnel Group the Internal Network is attached to. Conflicts with `site_id` and `network_id`.",
+			},
+			"tunnel_name": schema.StringAttribute{
+				Computed:    true,
+				Description: "The name of the Network Tunnel Group the Internal Network is attached to.",
+			},
+		},
+	}
+}
+
+func (r *InternalNetworkResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config InternalNetworkResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !config.IPAddress.IsNull() && !config.IPAddress.IsUnknown() {
+		if _, err := netip.ParseAddr(config.IPAddress.ValueString()); err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("ip_address"),
+				"Invalid IP Address",
+				fmt.Sprintf("%q is not a valid IP address.", config.IPAddress.ValueString()),
+			)
+		}
+	}
+
+	if !config.PrefixLength.IsNull() && !config.PrefixLength.IsUnknown() {
+		if length := config.PrefixLength.ValueInt64(); length < 8 || length > 32 {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("prefix_length"),
+				"Invalid Prefix Length",
+				fmt.Sprintf("The prefix length must be between 8 and 32, got %d.", length),
+			)
+		}
+	}
+
+	// Only count associations that are known; unknown values are checked again at apply time.
+	set, unknown := 0, false
+	for _, id := range []types.Int64{config.SiteID, config.NetworkID, config.TunnelID} {
+		if id.IsUnknown() {
+			unknown = true
+		} else if !id.IsNull() {
+			set++
+		}
+	}
+	if set > 1 || (set == 0 && !unknown) {
+		resp.Diagnostics.AddError(
+			"Invalid Internal Network Association",
+			"Exactly one of site_id, network_id or tunnel_id must be set.",
+		)
+	}
+}
+
+func (r *InternalNetworkResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*apiclient.APIClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *apiclient.APIClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *InternalNetworkResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan InternalNetworkResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	network, err := apiclient.CreateInternalNetwork(ctx, r.client, internalNetworkPayload(plan))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating Internal Network",
+			"Could not create internal network, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	mapInternalNetworkToModel(network, &plan)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *InternalNetworkResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state InternalNetworkResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := state.ID.ValueInt64()
+
+	network, err := apiclient.GetInternalNetwork(ctx, r.client, id)
+	if removeMissingResource(ctx, resp, err, "Internal network", strconv.FormatInt(id, 10)) {
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading Internal Network",
+			"Could not read internal network ID "+strconv.FormatInt(id, 10)+": "+err.Error(),
+		)
+		return
+	}
+
+	mapInternalNetworkToModel(network, &state)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *InternalNetworkResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan InternalNetworkResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := plan.ID.ValueInt64()
+
+	network, err := apiclient.UpdateInternalNetwork(ctx, r.client, id, internalNetworkPayload(plan))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating Internal Network",
+			"Could not update internal network ID "+strconv.FormatInt(id, 10)+": "+err.Error(),
+		)
+		return
+	}
+
+	mapInternalNetworkToModel(network, &plan)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *InternalNetworkResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state InternalNetworkResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := state.ID.ValueInt64()
+
+	err := apiclient.DeleteInternalNetwork(ctx, r.client, id)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error deleting Internal Network",
+			"Could not delete internal network ID "+strconv.FormatInt(id, 10)+": "+err.Error(),
+		)
+		return
+	}
+}
+
+func (r *InternalNetworkResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	id, err := strconv.ParseInt(req.ID, 10, 64)
+	if err != nil {
+		// Not a number, try to find by name
+		id, err = apiclient.GetInternalNetworkIDByName(ctx, r.client, req.ID)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error importing Internal Network",
+				fmt.Sprintf("Could not find internal network with name %q: %s", req.ID, err),
+			)
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+}
+
+func internalNetworkPayload(model InternalNetworkResourceModel) apiclient.InternalNetworkPayload {
+	return apiclient.InternalNetworkPayload{
+		Name:         model.Name.ValueString(),
+		IPAddress:    model.IPAddress.ValueString(),
+		PrefixLength: int(model.PrefixLength.ValueInt64()),
+		SiteID:       model.SiteID.ValueInt64(),
+		NetworkID:    model.NetworkID.ValueInt64(),
+		TunnelID:     model.TunnelID.ValueInt64(),
+	}
+}
+
+func mapInternalNetworkToModel(network *apiclient.InternalNetwork, model *InternalNetworkResourceModel) {
+	model.ID = types.Int64Value(network.OriginID)
+	model.Name = types.StringValue(network.Name)
+	model.IPAddress = types.StringValue(network.IPAddress)
+	model.PrefixLength = types.Int64Value(int64(network.PrefixLength))
+	model.SiteID = int64OrNull(network.SiteID)
+	model.SiteName = types.StringValue(network.SiteName)
+	model.NetworkID = int64OrNull(network.NetworkID)
+	model.NetworkName = types.StringValue(network.NetworkName)
+	model.TunnelID = int64OrNull(network.TunnelID)
+	model.TunnelName = types.StringValue(network.TunnelName)
+}
+
+// int64OrNull maps the zero value the API uses for a missing ID to null.
+func int64OrNull(v int64) types.Int64 {
+	if v == 0 {
+		return types.Int64Null()
+	}
+	return types.Int64Value(v)
+}
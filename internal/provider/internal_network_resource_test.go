@@ -0,0 +1,83 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccInternalNetworkResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccInternalNetworkResourceConfig("tf-acc-internal-network", "10.250.0.0", 24),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("sse_internal_network.test", "name", "tf-acc-internal-network"),
+					resource.TestCheckResourceAttr("sse_internal_network.test", "ip_address", "10.250.0.0"),
+					resource.TestCheckResourceAttr("sse_internal_network.test", "prefix_length", "24"),
+					resource.TestCheckResourceAttrSet("sse_internal_network.test", "id"),
+					resource.TestCheckResourceAttrSet("sse_internal_network.test", "tunnel_id"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "sse_internal_network.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// ImportState testing by Name
+			{
+				ResourceName:      "sse_internal_network.test",
+				ImportState:       true,
+				ImportStateId:     "tf-acc-internal-network",
+				ImportStateVerify: true,
+			},
+			// Update testing
+			{
+				Config: testAccInternalNetworkResourceConfig("tf-acc-internal-network-updated", "10.250.0.0", 23),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("sse_internal_network.test", "name", "tf-acc-internal-network-updated"),
+					resource.TestCheckResourceAttr("sse_internal_network.test", "prefix_length", "23"),
+				),
+			},
+			// Data sources
+			{
+				Config: testAccInternalNetworkResourceConfig("tf-acc-internal-network-updated", "10.250.0.0", 23) + `
+data "sse_internal_networks" "all" {
+  depends_on = [sse_internal_network.test]
+}
+
+data "sse_internal_network" "by_name" {
+  name = sse_internal_network.test.name
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.sse_internal_networks.all", "internal_networks.#"),
+					resource.TestCheckResourceAttrPair("data.sse_internal_network.by_name", "id", "sse_internal_network.test", "id"),
+					resource.TestCheckResourceAttr("data.sse_internal_network.by_name", "prefix_length", "23"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccInternalNetworkResourceConfig(name, ipAddress string, prefixLength int) string {
+	return fmt.Sprintf(`
+data "sse_network_tunnel_groups" "all" {}
+
+resource "sse_internal_network" "test" {
+  name          = %[1]q
+  ip_address    = %[2]q
+  prefix_length = %[3]d
+  tunnel_id     = data.sse_network_tunnel_groups.all.network_tunnel_groups[0].id
+}
+`, name, ipAddress, prefixLength)
+}
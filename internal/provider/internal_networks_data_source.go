@@ -0,0 +1,152 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cisco/terraform-provider-sse/internal/apiclient"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &InternalNetworksDataSource{}
+
+func NewInternalNetworksDataSource() datasource.DataSource {
+	return &InternalNetworksDataSource{}
+}
+
+type InternalNetworksDataSource struct {
+	client *apiclient.APIClient
+}
+
+type InternalNetworksDataSourceModel struct {
+	InternalNetworks []InternalNetworkModel `tfsdk:"internal_networks"`
+}
+
+type InternalNetworkModel struct {
+	ID           types.Int64  `tfsdk:"id"`
+	Name         types.String `tfsdk:"name"`
+	IPAddress    types.String `tfsdk:"ip_address"`
+	PrefixLength types.Int64  `tfsdk:"prefix_length"`
+	SiteID       types.Int64  `tfsdk:"site_id"`
+	SiteName     types.String `tfsdk:"site_name"`
+	NetworkID    types.Int64  `tfsdk:"network_id"`
+	NetworkName  types.String `tfsdk:"network_name"`
+	TunnelID     types.Int64  `tfsdk:"tunnel_id"`
+	TunnelName   types.String `tfsdk:"tunnel_name"`
+}
+
+func (d *InternalNetworksDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_internal_networks"
+}
+
+func (d *InternalNetworksDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Fetches the list of Internal Networks.",
+		Attributes: map[string]schema.Attribute{
+			"internal_networks": schema.ListNestedAttribute{
+				Computed: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: internalNetworkDataSourceAttributes(),
+				},
+			},
+		},
+	}
+}
+
+// internalNetworkDataSourceAttributes returns the computed attributes of an internal network.
+func internalNetworkDataSourceAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.Int64Attribute{
+			Computed:    true,
+			Description: "The origin ID of the internal network.",
+		},
+		"name": schema.StringAttribute{
+			Computed:    true,
+			Description: "The name of the internal network.",
+		},
+		"ip_address": schema.StringAttribute{
+			Computed:    true,
+			Description: "The IP address of the internal network.",
+		},
+		"prefix_length": schema.Int64Attribute{
+			Computed:    true,
+			Description: "The prefix length of the internal network.",
+		},
+		"site_id": schema.Int64Attribute{
+			Computed:    true,
+			Description: "The ID of the site the internal network is attached to.",
+		},
+		"site_name": schema.StringAttribute{
+			Computed:    true,
+			Description: "The name of the site the internal network is attached to.",
+		},
+		"network_id": schema.Int64Attribute{
+			Computed:    true,
+			Description: "The ID of the network the internal network is attached to.",
+		},
+		"network_name": schema.StringAttribute{
+			Computed:    true,
+			Description: "The name of the network the internal network is attached to.",
+		},
+		"tunnel_id": schema.Int64Attribute{
+			Computed:    true,
+			Description: "The ID of the network tunnel group the internal network is attached to.",
+		},
+		"tunnel_name": schema.StringAttribute{
+			Computed:    true,
+			Description: "The name of the network tunnel group the internal network is attached to.",
+		},
+	}
+}
+
+func (d *InternalNetworksDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*apiclient.APIClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *apiclient.APIClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *InternalNetworksDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state InternalNetworksDataSourceModel
+
+	networks, err := apiclient.GetInternalNetworks(ctx, d.client)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Internal Networks",
+			err.Error(),
+		)
+		return
+	}
+
+	for _, network := range networks {
+		state.InternalNetworks = append(state.InternalNetworks, InternalNetworkModel{
+			ID:           types.Int64Value(network.OriginID),
+			Name:         types.StringValue(network.Name),
+			IPAddress:    types.StringValue(network.IPAddress),
+			PrefixLength: types.Int64Value(int64(network.PrefixLength)),
+			SiteID:       int64OrNull(network.SiteID),
+			SiteName:     types.StringValue(network.SiteName),
+			NetworkID:    int64OrNull(network.NetworkID),
+			NetworkName:  types.StringValue(network.NetworkName),
+			TunnelID:     int64OrNull(network.TunnelID),
+			TunnelName:   types.StringValue(network.TunnelName),
+		})
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
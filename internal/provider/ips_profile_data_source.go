@@ -82,7 +82,7 @@ func (d *IPSProfileDataSource) Read(ctx context.Context, req datasource.ReadRequ
 
 	targetName := state.Name.ValueString()
 
-	profiles, err := d.client.GetIPSProfiles()
+	profiles, err := d.client.GetIPSProfiles(ctx)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Unable to Read IPS Profiles",
@@ -0,0 +1,448 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/cisco/terraform-provider-sse/internal/apiclient"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ resource.Resource = &IPSProfileResource{}
+var _ resource.ResourceWithImportState = &IPSProfileResource{}
+var _ resource.ResourceWithValidateConfig = &IPSProfileResource{}
+
+func NewIPSProfileResource() resource.Resource {
+	return &IPSProfileResource{}
+}
+
+type IPSProfileResource struct {
+	client *apiclient.APIClient
+}
+
+type IPSProfileResourceModel struct {
+	ID                 types.Int64  `tfsdk:"id"`
+	Name               types.String `tfsdk:"name"`
+	ApplyTo            types.String `tfsdk:"apply_to"`
+	SystemMode         types.String `tfsdk:"system_mode"`
+	SignatureOverrides types.Set    `tfsdk:"signature_overrides"`
+}
+
+type IPSSignatureOverrideModel struct {
+	GID    types.Int64  `tfsdk:"gid"`
+	SID    types.Int64  `tfsdk:"sid"`
+	Action types.String `tfsdk:"action"`
+}
+
+var ipsSignatureOverrideAttrTypes = map[string]attr.Type{
+	"gid":    types.Int64Type,
+	"sid":    types.Int64Type,
+	"action": types.StringType,
+}
+
+var (
+	ipsProfileApplyToValues    = []string{"connectivity-ips", "balanced-ips", "security-ips", "max-detect-ips"}
+	ipsProfileSystemModeValues = []string{"prevention", "detection"}
+	ipsSignatureActionValues   = []string{"block", "warn", "ignore"}
+)
+
+func (r *IPSProfileResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_ips_profile"
+}
+
+func (r *IPSProfileResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a custom IPS Profile. The profile starts from one of the system-defined signature policies and overrides the action of individual signatures.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				Computed:    true,
+				Description: "The ID of the IPS Profile.",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:    true,
+				Description: "The name of the IPS Profile, 1 to 50 characters without `%` or `*`.",
+			},
+			"apply_to": schema.StringAttribute{
+				Required:    true,
+				Description: "The system-defined signature policy the profile is based on: `connectivity-ips`, `balanced-ips`, `security-ips` or `max-detect-ips`. Changing it creates a new profile.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"system_mode": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString("prevention"),
+				Description: "Whether the IPS blocks matching traffic (`prevention`) or only logs it (`detection`). Defaults to `prevention`.",
+			},
+			"signature_overrides": schema.SetNestedAttribute{
+				Optional:    true,
+				Description: "The signatures whose action differs from the signature policy. Use `sse_ips_signatures` to find them.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"gid": schema.Int64Attribute{
+							Required:    true,
+							Description: "The generator ID (GID) of the signature.",
+						},
+						"sid": schema.Int64Attribute{
+							Required:    true,
+							Description: "The signature ID (SID) of the signature.",
+						},
+						"action": schema.StringAttribute{
+							Required:    true,
+							Description: "The action for the signature: `block`, `warn` or `ignore`.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *IPSProfileResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config IPSProfileResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !config.Name.IsNull() && !config.Name.IsUnknown() {
+		if name := config.Name.ValueString(); len(name) < 1 || len(name) > 50 || strings.ContainsAny(name, "%*") {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("name"),
+				"Invalid IPS Profile Name",
+				fmt.Sprintf("The name must have 1 to 50 characters and must not contain %% or *, got %q.", name),
+			)
+		}
+	}
+
+	if !config.ApplyTo.IsNull() && !config.ApplyTo.IsUnknown() {
+		if applyTo := config.ApplyTo.ValueString(); !slices.Contains(ipsProfileApplyToValues, applyTo) {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("apply_to"),
+				"Invalid Apply To",
+				fmt.Sprintf("apply_to must be one of %s, got %q.", strings.Join(ipsProfileApplyToValues, ", "), applyTo),
+			)
+		}
+	}
+
+	if !config.SystemMode.IsNull() && !config.SystemMode.IsUnknown() {
+		if mode := config.SystemMode.ValueString(); !slices.Contains(ipsProfileSystemModeValues, mode) {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("system_mode"),
+				"Invalid System Mode",
+				fmt.Sprintf("The system mode must be one of %s, got %q.", strings.Join(ipsProfileSystemModeValues, ", "), mode),
+			)
+		}
+	}
+
+	if config.SignatureOverrides.IsNull() || config.SignatureOverrides.IsUnknown() {
+		return
+	}
+	if len(config.SignatureOverrides.Elements()) == 0 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("signature_overrides"),
+			"Empty Signature Overrides",
+			"signature_overrides must not be empty. Omit the attribute instead.",
+		)
+		return
+	}
+
+	var overrides []IPSSignatureOverrideModel
+	resp.Diagnostics.Append(config.SignatureOverrides.ElementsAs(ctx, &overrides, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// A signature can only be in one of the block, warn and ignore lists.
+	seen := map[string]bool{}
+	for _, o := range overrides {
+		if !o.Action.IsUnknown() && !slices.Contains(ipsSignatureActionValues, o.Action.ValueString()) {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("signature_overrides"),
+				"Invalid Signature Action",
+				fmt.Sprintf("The action must be one of %s, got %q.", strings.Join(ipsSignatureActionValues, ", "), o.Action.ValueString()),
+			)
+		}
+		if o.GID.IsUnknown() || o.SID.IsUnknown() {
+			continue
+		}
+		key := ipsSignatureKey(o.GID.ValueInt64(), o.SID.ValueInt64())
+		if seen[key] {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("signature_overrides"),
+				"Duplicate Signature Override",
+				fmt.Sprintf("Signature %s is overridden more than once.", key),
+			)
+		}
+		seen[key] = true
+	}
+}
+
+func (r *IPSProfileResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*apiclient.APIClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *apiclient.APIClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *IPSProfileResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan IPSProfileResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	lists, diags := ipsSignatureLists(ctx, plan.SignatureOverrides)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	profile, err := apiclient.CreateIPSProfile(ctx, r.client, apiclient.IPSProfilePayload{
+		Name:       plan.Name.ValueString(),
+		SystemMode: plan.SystemMode.ValueString(),
+		ApplyTo:    plan.ApplyTo.ValueString(),
+		BlockList:  lists["block"],
+		WarnList:   lists["warn"],
+		IgnoreList: lists["ignore"],
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating IPS Profile",
+			"Could not create IPS profile, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(mapIPSProfileToModel(ctx, profile, &plan)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *IPSProfileResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state IPSProfileResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := state.ID.ValueInt64()
+
+	profile, err := apiclient.GetIPSProfile(ctx, r.client, id)
+	if removeMissingResource(ctx, resp, err, "IPS profile", strconv.FormatInt(id, 10)) {
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading IPS Profile",
+			"Could not read IPS profile ID "+strconv.FormatInt(id, 10)+": "+err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(mapIPSProfileToModel(ctx, profile, &state)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *IPSProfileResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state IPSProfileResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := plan.ID.ValueInt64()
+
+	planned, diags := ipsSignatureLists(ctx, plan.SignatureOverrides)
+	resp.Diagnostics.Append(diags...)
+	current, diags := ipsSignatureLists(ctx, state.SignatureOverrides)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var patches []apiclient.IPSProfilePatch
+	if !plan.Name.Equal(state.Name) {
+		patches = append(patches, apiclient.IPSProfilePatch{Op: "replace", Path: "/name", Value: plan.Name.ValueString()})
+	}
+	if !plan.SystemMode.Equal(state.SystemMode) {
+		patches = append(patches, apiclient.IPSProfilePatch{Op: "replace", Path: "/systemMode", Value: plan.SystemMode.ValueString()})
+	}
+
+	// Remove signatures from their old lists before adding them to new ones,
+	// as a signature may only be in one list at a time.
+	for _, op := range []string{"remove", "add"} {
+		for _, action := range ipsSignatureActionValues {
+			from, to := current[action], planned[action]
+			if op == "add" {
+				from, to = to, from
+			}
+			var changed []string
+			for _, key := range from {
+				if !slices.Contains(to, key) {
+					changed = append(changed, key)
+				}
+			}
+			if len(changed) > 0 {
+				patches = append(patches, apiclient.IPSProfilePatch{Op: op, Path: "/" + action + "List", Value: changed})
+			}
+		}
+	}
+
+	profile, err := apiclient.UpdateIPSProfile(ctx, r.client, id, patches)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating IPS Profile",
+			"Could not update IPS profile ID "+strconv.FormatInt(id, 10)+": "+err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(mapIPSProfileToModel(ctx, profile, &plan)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *IPSProfileResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state IPSProfileResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := state.ID.ValueInt64()
+
+	err := apiclient.DeleteIPSProfile(ctx, r.client, id)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error deleting IPS Profile",
+			"Could not delete IPS profile ID "+strconv.FormatInt(id, 10)+": "+err.Error(),
+		)
+		return
+	}
+}
+
+func (r *IPSProfileResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	id, err := strconv.ParseInt(req.ID, 10, 64)
+	if err != nil {
+		// Not a number, try to find by name
+		id, err = apiclient.GetIPSProfileIDByName(ctx, r.client, req.ID)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error importing IPS Profile",
+				fmt.Sprintf("Could not find IPS profile with name %q: %s", req.ID, err),
+			)
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+}
+
+// ipsSignatureLists returns the GID-SID pairs of the overrides, keyed by
+// action. Every action has a list, because the API requires all of them.
+func ipsSignatureLists(ctx context.Context, overrides types.Set) (map[string][]string, diag.Diagnostics) {
+	lists := map[string][]string{}
+	for _, action := range ipsSignatureActionValues {
+		lists[action] = []string{}
+	}
+	if overrides.IsNull() || overrides.IsUnknown() {
+		return lists, nil
+	}
+
+	var models []IPSSignatureOverrideModel
+	diags := overrides.ElementsAs(ctx, &models, false)
+	for _, o := range models {
+		action := o.Action.ValueString()
+		lists[action] = append(lists[action], ipsSignatureKey(o.GID.ValueInt64(), o.SID.ValueInt64()))
+	}
+	return lists, diags
+}
+
+func mapIPSProfileToModel(ctx context.Context, profile *apiclient.IPSProfile, model *IPSProfileResourceModel) diag.Diagnostics {
+	model.ID = types.Int64Value(int64(profile.ID))
+	model.Name = types.StringValue(profile.Name)
+	if profile.ApplyTo != "" {
+		model.ApplyTo = types.StringValue(profile.ApplyTo)
+	}
+	model.SystemMode = types.StringValue(profile.SystemMode)
+
+	var overrides []IPSSignatureOverrideModel
+	for action, keys := range map[string][]string{"block": profile.BlockList, "warn": profile.WarnList, "ignore": profile.IgnoreList} {
+		for _, key := range keys {
+			gid, sid, ok := parseIPSSignatureKey(key)
+			if !ok {
+				continue
+			}
+			overrides = append(overrides, IPSSignatureOverrideModel{
+				GID:    types.Int64Value(gid),
+				SID:    types.Int64Value(sid),
+				Action: types.StringValue(action),
+			})
+		}
+	}
+
+	elemType := types.ObjectType{AttrTypes: ipsSignatureOverrideAttrTypes}
+	if len(overrides) == 0 {
+		model.SignatureOverrides = types.SetNull(elemType)
+		return nil
+	}
+
+	var diags diag.Diagnostics
+	model.SignatureOverrides, diags = types.SetValueFrom(ctx, elemType, overrides)
+	return diags
+}
+
+// ipsSignatureKey returns the GID-SID form the API uses to identify a signature.
+func ipsSignatureKey(gid, sid int64) string {
+	return fmt.Sprintf("%d-%d", gid, sid)
+}
+
+func parseIPSSignatureKey(key string) (int64, int64, bool) {
+	g, s, ok := strings.Cut(key, "-")
+	if !ok {
+		return 0, 0, false
+	}
+	gid, err := strconv.ParseInt(g, 10, 64)
+	if err != nil {
+		return 0, 0, false
+	}
+	sid, err := strconv.ParseInt(s, 10, 64)
+	if err != nil {
+		return 0, 0, false
+	}
+	return gid, sid, true
+}
@@ -0,0 +1,95 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccIPSProfileResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccIPSProfileResourceConfig("tf-acc-test-ips-profile", "prevention", "block"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("sse_ips_profile.test", "name", "tf-acc-test-ips-profile"),
+					resource.TestCheckResourceAttr("sse_ips_profile.test", "apply_to", "balanced-ips"),
+					resource.TestCheckResourceAttr("sse_ips_profile.test", "system_mode", "prevention"),
+					resource.TestCheckResourceAttr("sse_ips_profile.test", "signature_overrides.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs("sse_ips_profile.test", "signature_overrides.*", map[string]string{
+						"gid":    "1",
+						"sid":    "58722",
+						"action": "block",
+					}),
+					resource.TestCheckResourceAttrSet("sse_ips_profile.test", "id"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "sse_ips_profile.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// ImportState testing by name
+			{
+				ResourceName:      "sse_ips_profile.test",
+				ImportState:       true,
+				ImportStateId:     "tf-acc-test-ips-profile",
+				ImportStateVerify: true,
+			},
+			// Update testing
+			{
+				Config: testAccIPSProfileResourceConfig("tf-acc-test-ips-profile-updated", "detection", "warn"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("sse_ips_profile.test", "name", "tf-acc-test-ips-profile-updated"),
+					resource.TestCheckResourceAttr("sse_ips_profile.test", "system_mode", "detection"),
+					resource.TestCheckTypeSetElemNestedAttrs("sse_ips_profile.test", "signature_overrides.*", map[string]string{
+						"gid":    "1",
+						"sid":    "58722",
+						"action": "warn",
+					}),
+				),
+			},
+			// Data source
+			{
+				Config: testAccIPSProfileResourceConfig("tf-acc-test-ips-profile-updated", "detection", "warn") + `
+data "sse_ips_signatures" "test" {
+  profile_id     = sse_ips_profile.test.id
+  overrides_only = true
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.sse_ips_signatures.test", "signatures.#", "1"),
+					resource.TestCheckResourceAttr("data.sse_ips_signatures.test", "signatures.0.sid", "58722"),
+					resource.TestCheckResourceAttr("data.sse_ips_signatures.test", "signatures.0.action", "warn"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccIPSProfileResourceConfig(name, systemMode, action string) string {
+	return fmt.Sprintf(`
+resource "sse_ips_profile" "test" {
+  name        = %[1]q
+  apply_to    = "balanced-ips"
+  system_mode = %[2]q
+
+  signature_overrides = [
+    {
+      gid    = 1
+      sid    = 58722
+      action = %[3]q
+    },
+  ]
+}
+`, name, systemMode, action)
+}
@@ -85,7 +85,7 @@ func (d *IPSProfilesDataSource) Configure(ctx context.Context, req datasource.Co
 func (d *IPSProfilesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
 	var state IPSProfilesDataSourceModel
 
-	profiles, err := d.client.GetIPSProfiles()
+	profiles, err := d.client.GetIPSProfiles(ctx)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Unable to Read IPS Profiles",
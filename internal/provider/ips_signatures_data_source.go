@@ -0,0 +1,270 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/cisco/terraform-provider-sse/internal/apiclient"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &IPSSignaturesDataSource{}
+var _ datasource.DataSourceWithValidateConfig = &IPSSignaturesDataSource{}
+
+func NewIPSSignaturesDataSource() datasource.DataSource {
+	return &IPSSignaturesDataSource{}
+}
+
+type IPSSignaturesDataSource struct {
+	client *apiclient.APIClient
+}
+
+type IPSSignaturesDataSourceModel struct {
+	ProfileID     types.Int64         `tfsdk:"profile_id"`
+	Search        types.String        `tfsdk:"search"`
+	CVE           types.String        `tfsdk:"cve"`
+	Category      types.String        `tfsdk:"category"`
+	Class         types.String        `tfsdk:"class"`
+	Action        types.String        `tfsdk:"action"`
+	OverridesOnly types.Bool          `tfsdk:"overrides_only"`
+	Signatures    []IPSSignatureModel `tfsdk:"signatures"`
+}
+
+type IPSSignatureModel struct {
+	ID             types.String   `tfsdk:"id"`
+	GID            types.Int64    `tfsdk:"gid"`
+	SID            types.Int64    `tfsdk:"sid"`
+	Name           types.String   `tfsdk:"name"`
+	Class          types.String   `tfsdk:"class"`
+	CVEs           []types.String `tfsdk:"cves"`
+	Action         types.String   `tfsdk:"action"`
+	OriginalAction types.String   `tfsdk:"original_action"`
+}
+
+// ipsSignatureClassPattern matches the classtype option of a Snort rule.
+var ipsSignatureClassPattern = regexp.MustCompile(`(?:^|[(;])\s*classtype:\s*([^;\s]+)\s*;`)
+
+func (d *IPSSignaturesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_ips_signatures"
+}
+
+func (d *IPSSignaturesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Searches the signatures of an IPS Profile by name, GID-SID, CVE, rule category or Snort class, for use in `sse_ips_profile.signature_overrides`.",
+		Attributes: map[string]schema.Attribute{
+			"profile_id": schema.Int64Attribute{
+				Required:    true,
+				Description: "The ID of the IPS profile whose signatures are searched. Use the `id` attribute of `sse_ips_profile`.",
+			},
+			"search": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only return signatures whose name, GID-SID or CVEs contain this string.",
+			},
+			"cve": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only return signatures for this CVE, e.g. `CVE-2021-44228` or `2021-44228`.",
+			},
+			"category": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only return signatures of this rule category, the prefix of the signature name such as `MALWARE-BACKDOOR` or `SERVER-WEBAPP`.",
+			},
+			"class": schema.StringAttribute{
+				Optional: true,
+				Description: "Only return signatures of this Snort class, the `classtype` of the rule such as `trojan-activity` or `attempted-admin`. " +
+					"The API cannot search by class, so it must be combined with `search`, `cve`, `category` or `overrides_only` to avoid reading every signature of the profile.",
+			},
+			"action": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only return signatures with this action in the profile: `block`, `warn` or `ignore`. By default signatures with any action are returned.",
+			},
+			"overrides_only": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Only return the signatures the profile overrides. `action` is ignored when set.",
+			},
+			"signatures": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "The matching signatures.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:    true,
+							Description: "The ID of the signature.",
+						},
+						"gid": schema.Int64Attribute{
+							Computed:    true,
+							Description: "The generator ID (GID) of the signature.",
+						},
+						"sid": schema.Int64Attribute{
+							Computed:    true,
+							Description: "The signature ID (SID) of the signature.",
+						},
+						"name": schema.StringAttribute{
+							Computed:    true,
+							Description: "The name of the signature.",
+						},
+						"class": schema.StringAttribute{
+							Computed:    true,
+							Description: "The Snort class of the signature, the `classtype` of the rule.",
+						},
+						"cves": schema.ListAttribute{
+							Computed:    true,
+							ElementType: types.StringType,
+							Description: "The CVEs the signature detects, without the `CVE-` prefix.",
+						},
+						"action": schema.StringAttribute{
+							Computed:    true,
+							Description: "The action of the signature in the profile. Null if `overrides_only` is set and the API does not report it.",
+						},
+						"original_action": schema.StringAttribute{
+							Computed:    true,
+							Description: "The action of the signature in the signature policy, if the profile overrides it.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *IPSSignaturesDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	var config IPSSignaturesDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !config.Action.IsNull() && !config.Action.IsUnknown() {
+		if action := config.Action.ValueString(); !slices.Contains(ipsSignatureActionValues, action) {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("action"),
+				"Invalid Signature Action",
+				fmt.Sprintf("The action must be one of %s, got %q.", strings.Join(ipsSignatureActionValues, ", "), action),
+			)
+		}
+	}
+
+	// The class is filtered on the client, so on its own it would page
+	// through every signature of the profile once per action.
+	if !config.Class.IsNull() && config.Search.IsNull() && config.CVE.IsNull() && config.Category.IsNull() &&
+		(config.OverridesOnly.IsNull() || (!config.OverridesOnly.IsUnknown() && !config.OverridesOnly.ValueBool())) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("class"),
+			"Class Requires Another Filter",
+			"class cannot be searched by the API and is filtered after all signatures of the profile are read. "+
+				"Combine it with search, cve, category or overrides_only.",
+		)
+	}
+}
+
+func (d *IPSSignaturesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*apiclient.APIClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *apiclient.APIClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *IPSSignaturesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state IPSSignaturesDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	cve := strings.TrimPrefix(strings.ToUpper(state.CVE.ValueString()), "CVE-")
+	category := strings.ToUpper(state.Category.ValueString())
+	class := state.Class.ValueString()
+
+	// The API searches names, GID-SIDs and CVEs. Without an explicit search
+	// the CVE or category narrows the request, and the results are filtered
+	// exactly below. The class is only in the rule text, which the API does
+	// not search, so it is filtered below as well.
+	filters := apiclient.IPSSignatureFilters{
+		SearchStr:     state.Search.ValueString(),
+		OverridesOnly: state.OverridesOnly.ValueBool(),
+	}
+	if filters.SearchStr == "" {
+		filters.SearchStr = cve
+	}
+	if filters.SearchStr == "" {
+		filters.SearchStr = category
+	}
+
+	// Without an action filter the API only returns blocking signatures, so
+	// every action is requested in turn.
+	actions := ipsSignatureActionValues
+	if filters.OverridesOnly {
+		actions = []string{""}
+	} else if !state.Action.IsNull() {
+		actions = []string{state.Action.ValueString()}
+	}
+
+	state.Signatures = []IPSSignatureModel{}
+	for _, action := range actions {
+		filters.Action = action
+		signatures, err := apiclient.GetIPSProfileSignatures(ctx, d.client, state.ProfileID.ValueInt64(), filters)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Unable to Read IPS Signatures",
+				err.Error(),
+			)
+			return
+		}
+
+		for _, sig := range signatures {
+			if cve != "" && !slices.ContainsFunc(sig.CVE, func(c string) bool { return strings.TrimPrefix(strings.ToUpper(c), "CVE-") == cve }) {
+				continue
+			}
+			if category != "" && !strings.HasPrefix(strings.ToUpper(sig.Name), category+" ") {
+				continue
+			}
+			sigClass := ""
+			if m := ipsSignatureClassPattern.FindStringSubmatch(sig.Description); m != nil {
+				sigClass = m[1]
+			}
+			if class != "" && !strings.EqualFold(sigClass, class) {
+				continue
+			}
+
+			model := IPSSignatureModel{
+				ID:             types.StringValue(sig.ID),
+				GID:            types.Int64Value(sig.GID),
+				SID:            types.Int64Value(sig.SID),
+				Name:           types.StringValue(sig.Name),
+				Class:          stringOrNull(sigClass),
+				CVEs:           []types.String{},
+				Action:         stringOrNull(action),
+				OriginalAction: types.StringPointerValue(sig.OriginalAction),
+			}
+			if sig.CurrentAction != nil {
+				model.Action = types.StringValue(*sig.CurrentAction)
+			}
+			for _, c := range sig.CVE {
+				model.CVEs = append(model.CVEs, types.StringValue(c))
+			}
+			state.Signatures = append(state.Signatures, model)
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
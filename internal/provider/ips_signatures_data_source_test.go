@@ -0,0 +1,83 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestIPSSignaturesDataSourceValidateConfig(t *testing.T) {
+	tests := map[string]struct {
+		config    map[string]tftypes.Value
+		wantError bool
+	}{
+		"class alone": {
+			config:    map[string]tftypes.Value{"class": tftypes.NewValue(tftypes.String, "trojan-activity")},
+			wantError: true,
+		},
+		"class without overrides": {
+			config: map[string]tftypes.Value{
+				"class":          tftypes.NewValue(tftypes.String, "trojan-activity"),
+				"overrides_only": tftypes.NewValue(tftypes.Bool, false),
+			},
+			wantError: true,
+		},
+		"class with category": {
+			config: map[string]tftypes.Value{
+				"class":    tftypes.NewValue(tftypes.String, "trojan-activity"),
+				"category": tftypes.NewValue(tftypes.String, "MALWARE-CNC"),
+			},
+		},
+		"class with unknown search": {
+			config: map[string]tftypes.Value{
+				"class":  tftypes.NewValue(tftypes.String, "trojan-activity"),
+				"search": tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+			},
+		},
+		"class with overrides": {
+			config: map[string]tftypes.Value{
+				"class":          tftypes.NewValue(tftypes.String, "trojan-activity"),
+				"overrides_only": tftypes.NewValue(tftypes.Bool, true),
+			},
+		},
+		"no class": {
+			config: map[string]tftypes.Value{},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctx := context.Background()
+			d := &IPSSignaturesDataSource{}
+
+			var schemaResp datasource.SchemaResponse
+			d.Schema(ctx, datasource.SchemaRequest{}, &schemaResp)
+
+			objectType := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+			values := map[string]tftypes.Value{}
+			for attrName, attrType := range objectType.AttributeTypes {
+				values[attrName] = tftypes.NewValue(attrType, nil)
+			}
+			values["profile_id"] = tftypes.NewValue(tftypes.Number, 1)
+			for attrName, value := range test.config {
+				values[attrName] = value
+			}
+
+			req := datasource.ValidateConfigRequest{
+				Config: tfsdk.Config{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, values)},
+			}
+			var resp datasource.ValidateConfigResponse
+			d.ValidateConfig(ctx, req, &resp)
+
+			if got := resp.Diagnostics.HasError(); got != test.wantError {
+				t.Errorf("HasError() = %t, want %t: %v", got, test.wantError, resp.Diagnostics)
+			}
+		})
+	}
+}
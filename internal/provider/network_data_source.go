@@ -0,0 +1,113 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cisco/terraform-provider-sse/internal/apiclient"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &NetworkDataSource{}
+
+func NewNetworkDataSource() datasource.DataSource {
+	return &NetworkDataSource{}
+}
+
+type NetworkDataSource struct {
+	client *apiclient.APIClient
+}
+
+func (d *NetworkDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_network"
+}
+
+func (d *NetworkDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	attributes := networkDataSourceAttributes()
+	attributes["id"] = schema.Int64Attribute{
+		Optional:    true,
+		Computed:    true,
+		Description: "The origin ID of the network to find. Either `id` or `name` must be set.",
+	}
+	attributes["name"] = schema.StringAttribute{
+		Optional:    true,
+		Computed:    true,
+		Description: "The name of the network to find. Either `id` or `name` must be set.",
+	}
+
+	resp.Schema = schema.Schema{
+		Description: "Fetches a single Network by ID or name.",
+		Attributes:  attributes,
+	}
+}
+
+func (d *NetworkDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*apiclient.APIClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *apiclient.APIClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *NetworkDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state NetworkModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if state.ID.IsNull() && state.Name.IsNull() {
+		resp.Diagnostics.AddError(
+			"Missing Network Identifier",
+			"Either id or name must be set to look up a network.",
+		)
+		return
+	}
+
+	id := state.ID.ValueInt64()
+	if state.ID.IsNull() {
+		var err error
+		id, err = apiclient.GetNetworkIDByName(ctx, d.client, state.Name.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Network Not Found",
+				err.Error(),
+			)
+			return
+		}
+	}
+
+	network, err := apiclient.GetNetwork(ctx, d.client, id)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Network",
+			err.Error(),
+		)
+		return
+	}
+
+	state.ID = types.Int64Value(network.OriginID)
+	state.Name = types.StringValue(network.Name)
+	state.IPAddress = types.StringValue(network.IPAddress)
+	state.PrefixLength = types.Int64Value(int64(network.PrefixLength))
+	state.IsDynamic = types.BoolValue(network.IsDynamic)
+	state.Status = types.StringValue(network.Status)
+	state.IsVerified = types.BoolValue(network.IsVerified)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
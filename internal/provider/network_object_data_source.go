@@ -0,0 +1,246 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cisco/terraform-provider-sse/internal/apiclient"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &NetworkObjectDataSource{}
+var _ datasource.DataSourceWithValidateConfig = &NetworkObjectDataSource{}
+
+func NewNetworkObjectDataSource() datasource.DataSource {
+	return &NetworkObjectDataSource{}
+}
+
+type NetworkObjectDataSource struct {
+	client *apiclient.APIClient
+}
+
+type NetworkObjectDataSourceModel struct {
+	Name        types.String              `tfsdk:"name"`
+	Kind        types.String              `tfsdk:"kind"`
+	ID          types.Int64               `tfsdk:"id"`
+	Type        types.String              `tfsdk:"type"`
+	Description types.String              `tfsdk:"description"`
+	Values      []NetworkObjectValueModel `tfsdk:"values"`
+	ObjectIDs   []types.Int64             `tfsdk:"object_ids"`
+	GroupIDs    []types.Int64             `tfsdk:"group_ids"`
+}
+
+type NetworkObjectValueModel struct {
+	Type      types.String   `tfsdk:"type"`
+	Addresses []types.String `tfsdk:"addresses"`
+}
+
+func (d *NetworkObjectDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_network_object"
+}
+
+func (d *NetworkObjectDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	attributes := unifiedObjectDataSourceAttributes("network")
+	attributes["type"] = schema.StringAttribute{
+		Computed:    true,
+		Description: "The type of the network object (`host`, `network`, `range` or `fqdn`), or `group` for a network object group.",
+	}
+	attributes["values"] = schema.ListNestedAttribute{
+		Computed:    true,
+		Description: "The value of the network object, or the inline values of the network object group.",
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: map[string]schema.Attribute{
+				"type": schema.StringAttribute{
+					Computed:    true,
+					Description: "The type of the value (`host`, `network`, `range` or `fqdn`).",
+				},
+				"addresses": schema.ListAttribute{
+					Computed:    true,
+					ElementType: types.StringType,
+					Description: "The addresses of the value.",
+				},
+			},
+		},
+	}
+
+	resp.Schema = schema.Schema{
+		Description: "Fetches a Network Object or Network Object Group by name, including the system-defined ones and those not managed by Terraform.",
+		Attributes:  attributes,
+	}
+}
+
+// unifiedObjectDataSourceAttributes returns the attributes shared by the
+// network and service object data sources. kind is either "network" or
+// "service".
+func unifiedObjectDataSourceAttributes(kind string) map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"name": schema.StringAttribute{
+			Required:    true,
+			Description: fmt.Sprintf("The name of the %[1]s object or %[1]s object group to find.", kind),
+		},
+		"kind": schema.StringAttribute{
+			Optional:    true,
+			Computed:    true,
+			Description: "Whether the result is an `object` or a `group`. Set it to choose between an object and a group with the same name.",
+		},
+		"id": schema.Int64Attribute{
+			Computed:    true,
+			Description: fmt.Sprintf("The ID of the %s object or group.", kind),
+		},
+		"description": schema.StringAttribute{
+			Computed:    true,
+			Description: fmt.Sprintf("The description of the %s object or group.", kind),
+		},
+		"object_ids": schema.ListAttribute{
+			Computed:    true,
+			ElementType: types.Int64Type,
+			Description: fmt.Sprintf("The IDs of the %s objects in the group. Empty for objects.", kind),
+		},
+		"group_ids": schema.ListAttribute{
+			Computed:    true,
+			ElementType: types.Int64Type,
+			Description: fmt.Sprintf("The IDs of the %s object groups nested in the group. Empty for objects.", kind),
+		},
+	}
+}
+
+func (d *NetworkObjectDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	var kind types.String
+
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("kind"), &kind)...)
+	resp.Diagnostics.Append(validateUnifiedObjectKind(kind)...)
+}
+
+// validateUnifiedObjectKind checks the kind attribute of the network and
+// service object data sources.
+func validateUnifiedObjectKind(kind types.String) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if kind.IsNull() || kind.IsUnknown() {
+		return diags
+	}
+	if v := kind.ValueString(); v != "object" && v != "group" {
+		diags.AddAttributeError(
+			path.Root("kind"),
+			"Invalid Kind",
+			fmt.Sprintf("The kind must be object or group, got %q.", v),
+		)
+	}
+	return diags
+}
+
+func (d *NetworkObjectDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*apiclient.APIClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *apiclient.APIClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *NetworkObjectDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state NetworkObjectDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	name := state.Name.ValueString()
+
+	matches, err := apiclient.GetUnifiedNetworkObjectsByName(ctx, d.client, name)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Network Objects",
+			err.Error(),
+		)
+		return
+	}
+
+	object, diags := findUnifiedObject(matches, func(o apiclient.UnifiedNetworkObject) string { return o.Type }, state.Kind, "network object", name)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	values := object.Values
+	objectType := object.Type
+	if object.Value != nil {
+		values = []apiclient.NetworkObjectValue{*object.Value}
+		if objectType == "" {
+			objectType = object.Value.Type
+		}
+	}
+
+	state.ID = types.Int64Value(object.ID)
+	state.Kind = types.StringValue(unifiedObjectKind(objectType))
+	state.Type = types.StringValue(objectType)
+	state.Description = types.StringValue(object.Description)
+	state.ObjectIDs = objectGroupMemberIDValues(object.Objects)
+	state.GroupIDs = objectGroupMemberIDValues(object.Groups)
+	state.Values = []NetworkObjectValueModel{}
+	for _, value := range values {
+		addresses := []types.String{}
+		for _, addr := range value.Addresses {
+			addresses = append(addresses, types.StringValue(addr))
+		}
+		state.Values = append(state.Values, NetworkObjectValueModel{
+			Type:      types.StringValue(value.Type),
+			Addresses: addresses,
+		})
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// unifiedObjectKind returns the kind of a unified object of the given type.
+func unifiedObjectKind(objectType string) string {
+	if objectType == apiclient.UnifiedObjectGroupType {
+		return "group"
+	}
+	return "object"
+}
+
+// findUnifiedObject returns the only match of the wanted kind. label names
+// the kind of object in error messages.
+func findUnifiedObject[T any](matches []T, typeOf func(T) string, kind types.String, label, name string) (T, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	var found []T
+	for _, match := range matches {
+		if kind.IsNull() || unifiedObjectKind(typeOf(match)) == kind.ValueString() {
+			found = append(found, match)
+		}
+	}
+
+	var zero T
+	switch len(found) {
+	case 1:
+		return found[0], diags
+	case 0:
+		diags.AddError(
+			"Object Not Found",
+			fmt.Sprintf("No %s or group with name %q was found.", label, name),
+		)
+	default:
+		detail := fmt.Sprintf("Both a %s and a group are named %q. Set kind to object or group to choose one.", label, name)
+		if !kind.IsNull() {
+			detail = fmt.Sprintf("%d objects of kind %s are named %q.", len(found), kind.ValueString(), name)
+		}
+		diags.AddAttributeError(path.Root("kind"), "Ambiguous Object Name", detail)
+	}
+	return zero, diags
+}
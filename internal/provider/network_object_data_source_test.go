@@ -0,0 +1,52 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccNetworkObjectDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+resource "sse_network_object" "test" {
+  name      = "tf-acc-test-unified-net"
+  type      = "network"
+  addresses = ["10.30.0.0/16"]
+}
+
+resource "sse_network_object_group" "test" {
+  name       = "tf-acc-test-unified-net-group"
+  object_ids = [sse_network_object.test.object_id]
+}
+
+data "sse_network_object" "object" {
+  name = sse_network_object.test.name
+}
+
+data "sse_network_object" "group" {
+  name = sse_network_object_group.test.name
+  kind = "group"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.sse_network_object.object", "id", "sse_network_object.test", "object_id"),
+					resource.TestCheckResourceAttr("data.sse_network_object.object", "kind", "object"),
+					resource.TestCheckResourceAttr("data.sse_network_object.object", "type", "network"),
+					resource.TestCheckResourceAttr("data.sse_network_object.object", "values.0.addresses.0", "10.30.0.0/16"),
+					resource.TestCheckResourceAttrPair("data.sse_network_object.group", "id", "sse_network_object_group.test", "id"),
+					resource.TestCheckResourceAttr("data.sse_network_object.group", "kind", "group"),
+					resource.TestCheckResourceAttr("data.sse_network_object.group", "type", "group"),
+					resource.TestCheckResourceAttrPair("data.sse_network_object.group", "object_ids.0", "sse_network_object.test", "object_id"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,347 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/cisco/terraform-provider-sse/internal/apiclient"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ resource.Resource = &NetworkObjectGroupResource{}
+var _ resource.ResourceWithImportState = &NetworkObjectGroupResource{}
+var _ resource.ResourceWithValidateConfig = &NetworkObjectGroupResource{}
+
+func NewNetworkObjectGroupResource() resource.Resource {
+	return &NetworkObjectGroupResource{}
+}
+
+type NetworkObjectGroupResource struct {
+	client *apiclient.APIClient
+}
+
+// ObjectGroupResourceModel describes both the network and the service object
+// group resources, which only differ in the kind of objects they reference.
+type ObjectGroupResourceModel struct {
+	ID          types.Int64  `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	Description types.String `tfsdk:"description"`
+	ObjectIDs   types.Set    `tfsdk:"object_ids"`
+	GroupIDs    types.Set    `tfsdk:"group_ids"`
+}
+
+func (r *NetworkObjectGroupResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_network_object_group"
+}
+
+func (r *NetworkObjectGroupResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a Network Object Group. A group combines network objects and other network object groups so that rules can reference them as one destination or source.",
+		Attributes:  objectGroupResourceAttributes("Network"),
+	}
+}
+
+// objectGroupResourceAttributes returns the attributes of a network or service
+// object group resource. kind is either "Network" or "Service".
+func objectGroupResourceAttributes(kind string) map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.Int64Attribute{
+			Computed:    true,
+			Description: fmt.Sprintf("The ID of the %s Object Group.", kind),
+			PlanModifiers: []planmodifier.Int64{
+				int64planmodifier.UseStateForUnknown(),
+			},
+		},
+		"name": schema.StringAttribute{
+			Required:    true,
+			Description: fmt.Sprintf("The name of the %s Object Group.", kind),
+		},
+		"description": schema.StringAttribute{
+			Optional:    true,
+			Description: fmt.Sprintf("The description of the %s Object Group.", kind),
+		},
+		"object_ids": schema.SetAttribute{
+			Optional:    true,
+			ElementType: types.Int64Type,
+			Description: fmt.Sprintf("The IDs of the %[1]s Objects in the group. Use the `object_id` attribute of `sse_%[2]s_object`.", kind, strings.ToLower(kind)),
+		},
+		"group_ids": schema.SetAttribute{
+			Optional:    true,
+			ElementType: types.Int64Type,
+			Description: fmt.Sprintf("The IDs of the %[1]s Object Groups nested in the group. Use the `id` attribute of `sse_%[2]s_object_group`.", kind, strings.ToLower(kind)),
+		},
+	}
+}
+
+func (r *NetworkObjectGroupResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config ObjectGroupResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(validateObjectGroupMembers(config)...)
+}
+
+// validateObjectGroupMembers checks that an object group has at least one
+// member. Empty sets are rejected as well, because the API reports them the
+// same way as unset ones.
+func validateObjectGroupMembers(config ObjectGroupResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	for _, attr := range []struct {
+		name string
+		set  types.Set
+	}{{"object_ids", config.ObjectIDs}, {"group_ids", config.GroupIDs}} {
+		if !attr.set.IsNull() && !attr.set.IsUnknown() && len(attr.set.Elements()) == 0 {
+			diags.AddAttributeError(
+				path.Root(attr.name),
+				"Empty Object Group Members",
+				fmt.Sprintf("%s must not be empty. Omit the attribute instead.", attr.name),
+			)
+		}
+	}
+
+	if config.ObjectIDs.IsNull() && config.GroupIDs.IsNull() {
+		diags.AddAttributeError(
+			path.Root("object_ids"),
+			"Missing Object Group Members",
+			"At least one of object_ids or group_ids must be set.",
+		)
+	}
+
+	return diags
+}
+
+func (r *NetworkObjectGroupResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*apiclient.APIClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *apiclient.APIClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *NetworkObjectGroupResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan ObjectGroupResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	payload, diags := networkObjectGroupPayload(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	group, err := apiclient.CreateNetworkObjectGroup(ctx, r.client, payload)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating Network Object Group",
+			"Could not create network object group, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(mapNetworkObjectGroupToModel(ctx, group, &plan)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *NetworkObjectGroupResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state ObjectGroupResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := state.ID.ValueInt64()
+
+	group, err := apiclient.GetNetworkObjectGroup(ctx, r.client, id)
+	if removeMissingResource(ctx, resp, err, "Network object group", strconv.FormatInt(id, 10)) {
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading Network Object Group",
+			"Could not read network object group ID "+strconv.FormatInt(id, 10)+": "+err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(mapNetworkObjectGroupToModel(ctx, group, &state)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *NetworkObjectGroupResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan ObjectGroupResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := plan.ID.ValueInt64()
+
+	payload, diags := networkObjectGroupPayload(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The update replaces the whole group. Keep the inline values, which may
+	// have been added in the dashboard, instead of deleting them.
+	current, err := apiclient.GetNetworkObjectGroup(ctx, r.client, id)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating Network Object Group",
+			"Could not read network object group ID "+strconv.FormatInt(id, 10)+": "+err.Error(),
+		)
+		return
+	}
+	if current.Values != nil {
+		payload.Values = current.Values
+	}
+
+	group, err := apiclient.UpdateNetworkObjectGroup(ctx, r.client, id, payload)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating Network Object Group",
+			"Could not update network object group ID "+strconv.FormatInt(id, 10)+": "+err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(mapNetworkObjectGroupToModel(ctx, group, &plan)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *NetworkObjectGroupResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state ObjectGroupResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := state.ID.ValueInt64()
+
+	err := apiclient.DeleteNetworkObjectGroup(ctx, r.client, id)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error deleting Network Object Group",
+			"Could not delete network object group ID "+strconv.FormatInt(id, 10)+": "+err.Error(),
+		)
+		return
+	}
+}
+
+func (r *NetworkObjectGroupResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	id, err := strconv.ParseInt(req.ID, 10, 64)
+	if err != nil {
+		// Not a number, try to find by name
+		id, err = apiclient.GetNetworkObjectGroupIDByName(ctx, r.client, req.ID)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error importing Network Object Group",
+				fmt.Sprintf("Could not find network object group with name %q: %s", req.ID, err),
+			)
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+}
+
+// networkObjectGroupPayload builds the request body for model. Inline values
+// are not managed by the resource: new groups have none, and updates send
+// back the values of the existing group.
+func networkObjectGroupPayload(ctx context.Context, model ObjectGroupResourceModel) (apiclient.NetworkObjectGroupPayload, diag.Diagnostics) {
+	payload := apiclient.NetworkObjectGroupPayload{
+		Name:        model.Name.ValueString(),
+		Description: model.Description.ValueString(),
+		Values:      []apiclient.NetworkObjectValue{},
+	}
+
+	var diags diag.Diagnostics
+	payload.ObjectIDs, payload.GroupIDs, diags = objectGroupMemberIDs(ctx, model)
+	return payload, diags
+}
+
+func mapNetworkObjectGroupToModel(ctx context.Context, group *apiclient.NetworkObjectGroup, model *ObjectGroupResourceModel) diag.Diagnostics {
+	model.ID = types.Int64Value(group.ID)
+	model.Name = types.StringValue(group.Name)
+	model.Description = stringOrNull(group.Description)
+
+	return mapObjectGroupMembers(ctx, group.Objects, group.Groups, model)
+}
+
+// objectGroupMemberIDs returns the object and group IDs of model. The API
+// requires both lists, so unset attributes are sent as empty lists.
+func objectGroupMemberIDs(ctx context.Context, model ObjectGroupResourceModel) ([]int64, []int64, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	objectIDs := []int64{}
+	if !model.ObjectIDs.IsNull() && !model.ObjectIDs.IsUnknown() {
+		diags.Append(model.ObjectIDs.ElementsAs(ctx, &objectIDs, false)...)
+	}
+
+	groupIDs := []int64{}
+	if !model.GroupIDs.IsNull() && !model.GroupIDs.IsUnknown() {
+		diags.Append(model.GroupIDs.ElementsAs(ctx, &groupIDs, false)...)
+	}
+
+	return objectIDs, groupIDs, diags
+}
+
+// mapObjectGroupMembers sets object_ids and group_ids from the members returned
+// by the API. No members is modelled as an unset attribute.
+func mapObjectGroupMembers(ctx context.Context, objects, groups []apiclient.ObjectGroupMember, model *ObjectGroupResourceModel) diag.Diagnostics {
+	var diags, d diag.Diagnostics
+
+	model.ObjectIDs, d = int64SetOrNull(ctx, objectGroupMemberIDList(objects))
+	diags.Append(d...)
+
+	model.GroupIDs, d = int64SetOrNull(ctx, objectGroupMemberIDList(groups))
+	diags.Append(d...)
+
+	return diags
+}
+
+func objectGroupMemberIDList(members []apiclient.ObjectGroupMember) []int64 {
+	ids := make([]int64, 0, len(members))
+	for _, member := range members {
+		ids = append(ids, member.ID)
+	}
+	return ids
+}
+
+// int64SetOrNull maps an empty list of IDs to a null set.
+func int64SetOrNull(ctx context.Context, ids []int64) (types.Set, diag.Diagnostics) {
+	if len(ids) == 0 {
+		return types.SetNull(types.Int64Type), nil
+	}
+	return types.SetValueFrom(ctx, types.Int64Type, ids)
+}
@@ -0,0 +1,187 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strconv"
+	"testing"
+
+	"github.com/cisco/terraform-provider-sse/internal/apiclient"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccNetworkObjectGroupResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccNetworkObjectGroupResourceConfig("tf-acc-test-net-group", false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("sse_network_object_group.test", "name", "tf-acc-test-net-group"),
+					resource.TestCheckResourceAttr("sse_network_object_group.test", "object_ids.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair("sse_network_object_group.test", "object_ids.*", "sse_network_object.web", "object_id"),
+					resource.TestCheckNoResourceAttr("sse_network_object_group.test", "group_ids"),
+					resource.TestCheckResourceAttrSet("sse_network_object_group.test", "id"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "sse_network_object_group.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// ImportState testing by name
+			{
+				ResourceName:      "sse_network_object_group.test",
+				ImportState:       true,
+				ImportStateId:     "tf-acc-test-net-group",
+				ImportStateVerify: true,
+			},
+			// Update testing with a nested group
+			{
+				Config: testAccNetworkObjectGroupResourceConfig("tf-acc-test-net-group-updated", true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("sse_network_object_group.test", "name", "tf-acc-test-net-group-updated"),
+					resource.TestCheckResourceAttr("sse_network_object_group.test", "group_ids.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair("sse_network_object_group.test", "group_ids.*", "sse_network_object_group.nested", "id"),
+				),
+			},
+			// Data source
+			{
+				Config: testAccNetworkObjectGroupResourceConfig("tf-acc-test-net-group-updated", true) + `
+data "sse_network_object_groups" "all" {
+  depends_on = [sse_network_object_group.test]
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.sse_network_object_groups.all", "network_object_groups.#"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccNetworkObjectGroupResourceConfig(name string, nested bool) string {
+	groupIDs := ""
+	if nested {
+		groupIDs = "group_ids  = [sse_network_object_group.nested.id]"
+	}
+
+	return fmt.Sprintf(`
+resource "sse_network_object" "web" {
+  name      = "tf-acc-test-net-group-web"
+  type      = "host"
+  addresses = ["10.10.10.10"]
+}
+
+resource "sse_network_object" "db" {
+  name      = "tf-acc-test-net-group-db"
+  type      = "network"
+  addresses = ["10.20.0.0/16"]
+}
+
+resource "sse_network_object_group" "nested" {
+  name       = "tf-acc-test-net-group-nested"
+  object_ids = [sse_network_object.db.object_id]
+}
+
+resource "sse_network_object_group" "test" {
+  name       = %[1]q
+  object_ids = [sse_network_object.web.object_id]
+  %[2]s
+}
+`, name, groupIDs)
+}
+
+func TestAccNetworkObjectGroupResource_inlineValues(t *testing.T) {
+	const name = "tf-acc-test-net-group-inline"
+	inline := apiclient.NetworkObjectValue{Type: "host", Addresses: []string{"10.30.30.30"}}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Import a group with inline values created in the dashboard
+			{
+				PreConfig: func() {
+					_, err := apiclient.CreateNetworkObjectGroup(context.Background(), testAccAPIClient(t), apiclient.NetworkObjectGroupPayload{
+						Name:      name,
+						ObjectIDs: []int64{},
+						GroupIDs:  []int64{},
+						Values:    []apiclient.NetworkObjectValue{inline},
+					})
+					if err != nil {
+						t.Fatalf("failed to create network object group: %s", err)
+					}
+				},
+				Config:             testAccNetworkObjectGroupInlineValuesConfig(name),
+				ResourceName:       "sse_network_object_group.test",
+				ImportState:        true,
+				ImportStateId:      name,
+				ImportStatePersist: true,
+			},
+			// Update testing keeps the inline values
+			{
+				Config: testAccNetworkObjectGroupInlineValuesConfig(name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("sse_network_object_group.test", "object_ids.#", "1"),
+					resource.TestCheckResourceAttr("sse_network_object_group.test", "description", "Imported group"),
+					testAccCheckNetworkObjectGroupValues(t, "sse_network_object_group.test", inline),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+// testAccCheckNetworkObjectGroupValues checks that the group still has the
+// inline value, which the resource does not manage.
+func testAccCheckNetworkObjectGroupValues(t *testing.T, resourceName string, value apiclient.NetworkObjectValue) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("resource %s not found in state", resourceName)
+		}
+
+		id, err := strconv.ParseInt(rs.Primary.ID, 10, 64)
+		if err != nil {
+			return fmt.Errorf("invalid ID %q: %w", rs.Primary.ID, err)
+		}
+
+		group, err := apiclient.GetNetworkObjectGroup(context.Background(), testAccAPIClient(t), id)
+		if err != nil {
+			return err
+		}
+
+		if !slices.ContainsFunc(group.Values, func(v apiclient.NetworkObjectValue) bool {
+			return v.Type == value.Type && slices.Equal(v.Addresses, value.Addresses)
+		}) {
+			return fmt.Errorf("network object group %d lost its inline values, got %v", id, group.Values)
+		}
+		return nil
+	}
+}
+
+func testAccNetworkObjectGroupInlineValuesConfig(name string) string {
+	return fmt.Sprintf(`
+resource "sse_network_object" "web" {
+  name      = "tf-acc-test-net-group-inline-web"
+  type      = "host"
+  addresses = ["10.10.10.11"]
+}
+
+resource "sse_network_object_group" "test" {
+  name        = %[1]q
+  description = "Imported group"
+  object_ids  = [sse_network_object.web.object_id]
+}
+`, name)
+}
@@ -0,0 +1,135 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cisco/terraform-provider-sse/internal/apiclient"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &NetworkObjectGroupsDataSource{}
+
+func NewNetworkObjectGroupsDataSource() datasource.DataSource {
+	return &NetworkObjectGroupsDataSource{}
+}
+
+type NetworkObjectGroupsDataSource struct {
+	client *apiclient.APIClient
+}
+
+type NetworkObjectGroupsDataSourceModel struct {
+	NetworkObjectGroups []ObjectGroupModel `tfsdk:"network_object_groups"`
+}
+
+// ObjectGroupModel describes a network or service object group in the plural
+// data sources.
+type ObjectGroupModel struct {
+	ID          types.Int64   `tfsdk:"id"`
+	Name        types.String  `tfsdk:"name"`
+	Description types.String  `tfsdk:"description"`
+	ObjectIDs   []types.Int64 `tfsdk:"object_ids"`
+	GroupIDs    []types.Int64 `tfsdk:"group_ids"`
+}
+
+func (d *NetworkObjectGroupsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_network_object_groups"
+}
+
+func (d *NetworkObjectGroupsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Fetches the list of Network Object Groups.",
+		Attributes: map[string]schema.Attribute{
+			"network_object_groups": schema.ListNestedAttribute{
+				Computed: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: objectGroupDataSourceAttributes("network"),
+				},
+			},
+		},
+	}
+}
+
+// objectGroupDataSourceAttributes returns the computed attributes of a network
+// or service object group. kind is either "network" or "service".
+func objectGroupDataSourceAttributes(kind string) map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.Int64Attribute{
+			Computed:    true,
+			Description: fmt.Sprintf("The ID of the %s object group.", kind),
+		},
+		"name": schema.StringAttribute{
+			Computed:    true,
+			Description: fmt.Sprintf("The name of the %s object group.", kind),
+		},
+		"description": schema.StringAttribute{
+			Computed:    true,
+			Description: fmt.Sprintf("The description of the %s object group.", kind),
+		},
+		"object_ids": schema.ListAttribute{
+			Computed:    true,
+			ElementType: types.Int64Type,
+			Description: fmt.Sprintf("The IDs of the %s objects in the group.", kind),
+		},
+		"group_ids": schema.ListAttribute{
+			Computed:    true,
+			ElementType: types.Int64Type,
+			Description: fmt.Sprintf("The IDs of the %s object groups nested in the group.", kind),
+		},
+	}
+}
+
+func (d *NetworkObjectGroupsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*apiclient.APIClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *apiclient.APIClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *NetworkObjectGroupsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state NetworkObjectGroupsDataSourceModel
+
+	groups, err := apiclient.GetNetworkObjectGroups(ctx, d.client)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Network Object Groups",
+			err.Error(),
+		)
+		return
+	}
+
+	for _, group := range groups {
+		state.NetworkObjectGroups = append(state.NetworkObjectGroups, ObjectGroupModel{
+			ID:          types.Int64Value(group.ID),
+			Name:        types.StringValue(group.Name),
+			Description: types.StringValue(group.Description),
+			ObjectIDs:   objectGroupMemberIDValues(group.Objects),
+			GroupIDs:    objectGroupMemberIDValues(group.Groups),
+		})
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func objectGroupMemberIDValues(members []apiclient.ObjectGroupMember) []types.Int64 {
+	ids := []types.Int64{}
+	for _, member := range members {
+		ids = append(ids, types.Int64Value(member.ID))
+	}
+	return ids
+}
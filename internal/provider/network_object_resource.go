@@ -6,6 +6,7 @@ package provider
 import (
 	"context"
 	"fmt"
+	"slices"
 	"strconv"
 
 	"github.com/cisco/terraform-provider-sse/internal/apiclient"
@@ -21,6 +22,7 @@ import (
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &NetworkObjectResource{}
 var _ resource.ResourceWithImportState = &NetworkObjectResource{}
+var _ resource.ResourceWithModifyPlan = &NetworkObjectResource{}
 
 func NewNetworkObjectResource() resource.Resource {
 	return &NetworkObjectResource{}
@@ -104,6 +106,51 @@ func (r *NetworkObjectResource) Configure(ctx context.Context, req resource.Conf
 	r.client = client
 }
 
+// ModifyPlan has the API validate new and changed network objects, so that
+// invalid addresses fail at plan time instead of during the apply.
+func (r *NetworkObjectResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to check when destroying
+	if req.Plan.Raw.IsNull() || r.client == nil {
+		return
+	}
+
+	// The model cannot hold an unknown list, e.g. addresses taken from another
+	// resource, and such a plan cannot be validated anyway.
+	var addresses types.List
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("addresses"), &addresses)...)
+	if resp.Diagnostics.HasError() || addresses.IsUnknown() {
+		return
+	}
+
+	var plan, state NetworkObjectResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if !req.State.Raw.IsNull() {
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	changed := !plan.Name.Equal(state.Name) || !plan.Type.Equal(state.Type) || !plan.Description.Equal(state.Description) ||
+		!slices.EqualFunc(plan.Addresses, state.Addresses, func(a, b types.String) bool { return a.Equal(b) })
+	if !changed {
+		return
+	}
+	if !knownStrings(append([]types.String{plan.Name, plan.Description, plan.Type}, plan.Addresses...)...) {
+		return
+	}
+
+	object := apiclient.NetworkObjectPayload{
+		Name:        plan.Name.ValueString(),
+		Description: plan.Description.ValueString(),
+		Value:       networkObjectValue(plan.Type, plan.Addresses),
+	}
+	err := apiclient.ValidateNetworkObjects(ctx, r.client, []apiclient.NetworkObjectPayload{object})
+	resp.Diagnostics.Append(objectValidationDiagnostics(err, "Network Object", !req.State.Raw.IsNull() && plan.Name.Equal(state.Name), func(field string) path.Path {
+		return networkObjectFieldPath(path.Empty(), field)
+	}, nil)...)
+}
+
 func (r *NetworkObjectResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var data NetworkObjectResourceModel
 
@@ -114,17 +161,9 @@ func (r *NetworkObjectResource) Create(ctx context.Context, req resource.CreateR
 		return
 	}
 
-	var addresses []string
-	for _, addr := range data.Addresses {
-		addresses = append(addresses, addr.ValueString())
-	}
+	value := networkObjectValue(data.Type, data.Addresses)
 
-	value := apiclient.NetworkObjectValue{
-		Type:      data.Type.ValueString(),
-		Addresses: addresses,
-	}
-
-	id, err := apiclient.PostNetworkObject(r.client, data.Name.ValueString(), value, data.Description.ValueString())
+	id, err := apiclient.PostNetworkObject(ctx, r.client, data.Name.ValueString(), value, data.Description.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error creating network object",
@@ -164,7 +203,10 @@ func (r *NetworkObjectResource) Read(ctx context.Context, req resource.ReadReque
 		return
 	}
 
-	obj, err := apiclient.GetNetworkObjectDetails(r.client, data.ID.ValueString())
+	obj, err := apiclient.GetNetworkObjectDetails(ctx, r.client, data.ID.ValueString())
+	if removeMissingResource(ctx, resp, err, "Network object", data.ID.ValueString()) {
+		return
+	}
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error reading network object",
@@ -173,11 +215,6 @@ func (r *NetworkObjectResource) Read(ctx context.Context, req resource.ReadReque
 		return
 	}
 
-	if obj == nil {
-		resp.State.RemoveResource(ctx)
-		return
-	}
-
 	// Map response body to model
 	objMap, ok := obj.(map[string]interface{})
 	if !ok {
@@ -229,17 +266,9 @@ func (r *NetworkObjectResource) Update(ctx context.Context, req resource.UpdateR
 		return
 	}
 
-	var addresses []string
-	for _, addr := range data.Addresses {
-		addresses = append(addresses, addr.ValueString())
-	}
-
-	value := apiclient.NetworkObjectValue{
-		Type:      data.Type.ValueString(),
-		Addresses: addresses,
-	}
+	value := networkObjectValue(data.Type, data.Addresses)
 
-	err := apiclient.PutNetworkObjectDetails(r.client, data.ID.ValueString(), data.Name.ValueString(), value, data.Description.ValueString())
+	err := apiclient.PutNetworkObjectDetails(ctx, r.client, data.ID.ValueString(), data.Name.ValueString(), value, data.Description.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error updating network object",
@@ -276,7 +305,7 @@ func (r *NetworkObjectResource) Delete(ctx context.Context, req resource.DeleteR
 		return
 	}
 
-	err := apiclient.DeleteNetworkObject(r.client, data.ID.ValueString())
+	err := apiclient.DeleteNetworkObject(ctx, r.client, data.ID.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error deleting network object",
@@ -307,7 +336,7 @@ func (r *NetworkObjectResource) ImportState(ctx context.Context, req resource.Im
 
 	if !isNumeric {
 		// Assume it's a name
-		foundID, err := apiclient.GetNetworkObjectIDByName(r.client, id)
+		foundID, err := apiclient.GetNetworkObjectIDByName(ctx, r.client, id)
 		if err != nil {
 			resp.Diagnostics.AddError(
 				"Error importing network object",
@@ -321,3 +350,22 @@ func (r *NetworkObjectResource) ImportState(ctx context.Context, req resource.Im
 
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 }
+
+func networkObjectValue(objectType types.String, addresses []types.String) apiclient.NetworkObjectValue {
+	value := apiclient.NetworkObjectValue{Type: objectType.ValueString()}
+	for _, addr := range addresses {
+		value.Addresses = append(value.Addresses, addr.ValueString())
+	}
+	return value
+}
+
+// networkObjectFieldPath returns the attribute below parent that a field
+// reported by the validate endpoint refers to.
+func networkObjectFieldPath(parent path.Path, field string) path.Path {
+	switch field {
+	case "name", "description", "type":
+		return parent.AtName(field)
+	default:
+		return parent.AtName("addresses")
+	}
+}
@@ -4,9 +4,14 @@
 package provider
 
 import (
+	"context"
 	"fmt"
 	"testing"
 
+	"github.com/cisco/terraform-provider-sse/internal/apiclient"
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 )
 
@@ -17,7 +22,7 @@ func TestAccNetworkObjectResource(t *testing.T) {
 		Steps: []resource.TestStep{
 			// Create and Read testing
 			{
-				Config: testAccNetworkObjectResourceConfig("test-net-obj", "1.1.1.1"),
+				Config: testAccNetworkObjectResourceConfig("test-net-obj", "1.1.1.1", "Acceptance Test Network Object"),
 				Check: resource.ComposeAggregateTestCheckFunc(
 					resource.TestCheckResourceAttr("sse_network_object.test", "name", "test-net-obj"),
 					resource.TestCheckResourceAttr("sse_network_object.test", "type", "host"),
@@ -32,22 +37,63 @@ func TestAccNetworkObjectResource(t *testing.T) {
 			},
 			// Update and Read testing
 			{
-				Config: testAccNetworkObjectResourceConfig("test-net-obj-updated", "2.2.2.2"),
+				Config: testAccNetworkObjectResourceConfig("test-net-obj-updated", "2.2.2.2", "Acceptance Test Network Object"),
 				Check: resource.ComposeAggregateTestCheckFunc(
 					resource.TestCheckResourceAttr("sse_network_object.test", "name", "test-net-obj-updated"),
 					resource.TestCheckResourceAttr("sse_network_object.test", "addresses.0", "2.2.2.2"),
 				),
 			},
+			// Update testing of the description only. The object keeps its
+			// name, which must not fail the plan-time validation.
+			{
+				Config: testAccNetworkObjectResourceConfig("test-net-obj-updated", "2.2.2.2", "Updated description"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("sse_network_object.test", "name", "test-net-obj-updated"),
+					resource.TestCheckResourceAttr("sse_network_object.test", "description", "Updated description"),
+				),
+			},
 		},
 	})
 }
 
-func testAccNetworkObjectResourceConfig(name, address string) string {
+func testAccNetworkObjectResourceConfig(name, address, description string) string {
 	return fmt.Sprintf(`
 resource "sse_network_object" "test" {
-  name      = %[1]q
-  type      = "host"
-  addresses = [%[2]q]
+  name        = %[1]q
+  description = %[3]q
+  type        = "host"
+  addresses   = [%[2]q]
 }
-`, name, address)
+`, name, address, description)
+}
+
+// TestNetworkObjectResourceModifyPlanUnknownAddresses checks that addresses
+// only known after the apply skip the validation instead of failing the plan.
+func TestNetworkObjectResourceModifyPlanUnknownAddresses(t *testing.T) {
+	ctx := context.Background()
+	// The client is never used: the validation is skipped before any request.
+	r := &NetworkObjectResource{client: &apiclient.APIClient{}}
+
+	var schemaResp fwresource.SchemaResponse
+	r.Schema(ctx, fwresource.SchemaRequest{}, &schemaResp)
+
+	objectType := schemaResp.Schema.Type().TerraformType(ctx)
+	plan := tfsdk.Plan{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, map[string]tftypes.Value{
+		"id":          tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+		"object_id":   tftypes.NewValue(tftypes.Number, tftypes.UnknownValue),
+		"name":        tftypes.NewValue(tftypes.String, "tf-unit-test-web"),
+		"description": tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+		"type":        tftypes.NewValue(tftypes.String, "host"),
+		"addresses":   tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, tftypes.UnknownValue),
+	})}
+	req := fwresource.ModifyPlanRequest{
+		Plan:  plan,
+		State: tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, nil)},
+	}
+	resp := fwresource.ModifyPlanResponse{Plan: plan}
+
+	r.ModifyPlan(ctx, req, &resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+	}
 }
@@ -0,0 +1,546 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/cisco/terraform-provider-sse/internal/apiclient"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ resource.Resource = &NetworkObjectsBulkResource{}
+var _ resource.ResourceWithModifyPlan = &NetworkObjectsBulkResource{}
+
+func NewNetworkObjectsBulkResource() resource.Resource {
+	return &NetworkObjectsBulkResource{}
+}
+
+type NetworkObjectsBulkResource struct {
+	client *apiclient.APIClient
+}
+
+type NetworkObjectsBulkResourceModel struct {
+	ID        types.String `tfsdk:"id"`
+	Objects   types.Map    `tfsdk:"objects"`
+	ObjectIDs types.Map    `tfsdk:"object_ids"`
+}
+
+type NetworkObjectsBulkItemModel struct {
+	Type        types.String `tfsdk:"type"`
+	Addresses   types.List   `tfsdk:"addresses"`
+	Description types.String `tfsdk:"description"`
+}
+
+var networkObjectsBulkItemAttrTypes = map[string]attr.Type{
+	"type":        types.StringType,
+	"addresses":   types.ListType{ElemType: types.StringType},
+	"description": types.StringType,
+}
+
+func (r *NetworkObjectsBulkResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_network_objects_bulk"
+}
+
+func (r *NetworkObjectsBulkResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages many Network Objects at once. New objects are created with a single CSV upload instead of one request per object, which makes large lists of hosts and networks practical to manage.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The identifier of the resource, the lowest ID of the Network Objects created with it.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"objects": schema.MapNestedAttribute{
+				Required:    true,
+				Description: "The Network Objects, keyed by name.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"type": schema.StringAttribute{
+							Required:    true,
+							Description: "The type of the Network Object (`host`, `network`, `range` or `fqdn`).",
+						},
+						"addresses": schema.ListAttribute{
+							Required:    true,
+							ElementType: types.StringType,
+							Description: "The addresses of the Network Object.",
+						},
+						"description": schema.StringAttribute{
+							Optional:    true,
+							Description: "The description of the Network Object.",
+						},
+					},
+				},
+			},
+			"object_ids": schema.MapAttribute{
+				Computed:    true,
+				ElementType: types.Int64Type,
+				Description: "The IDs of the Network Objects, keyed by name. Use them in `sse_network_object_group.object_ids` and in access rule conditions.",
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *NetworkObjectsBulkResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*apiclient.APIClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *apiclient.APIClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+// ModifyPlan marks object_ids as unknown when objects change and has the API
+// validate the new and changed objects, so that invalid addresses fail at plan
+// time.
+func (r *NetworkObjectsBulkResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to check when destroying
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan, state NetworkObjectsBulkResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if !req.State.Raw.IsNull() {
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	}
+	if resp.Diagnostics.HasError() || plan.Objects.IsUnknown() || plan.Objects.Equal(state.Objects) {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("object_ids"), types.MapUnknown(types.Int64Type))...)
+
+	planned, diags := networkObjectsBulkItems(ctx, plan.Objects)
+	resp.Diagnostics.Append(diags...)
+	current, diags := networkObjectsBulkItems(ctx, state.Objects)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() || r.client == nil {
+		return
+	}
+
+	// Objects that already exist keep their names, which the validate
+	// endpoint may reject as duplicates, so they are validated separately.
+	var added, changed networkObjectsBulkValidation
+	for _, name := range slices.Sorted(maps.Keys(planned)) {
+		item := planned[name]
+		old, exists := current[name]
+		if exists && networkObjectsBulkItemEqual(item, old) {
+			continue
+		}
+		// Objects built from values known only after the apply cannot be validated yet
+		if item.Addresses.IsUnknown() || !knownStrings(append([]types.String{item.Type, item.Description}, listStrings(item.Addresses)...)...) {
+			continue
+		}
+		batch := &added
+		if exists {
+			batch = &changed
+		}
+		batch.names = append(batch.names, name)
+		batch.objects = append(batch.objects, networkObjectsBulkPayload(name, item))
+	}
+
+	resp.Diagnostics.Append(r.validateObjects(ctx, added, false)...)
+	resp.Diagnostics.Append(r.validateObjects(ctx, changed, true)...)
+}
+
+// networkObjectsBulkValidation is a batch of objects sent to the validate endpoint.
+type networkObjectsBulkValidation struct {
+	names   []string
+	objects []apiclient.NetworkObjectPayload
+}
+
+// validateObjects has the API validate a batch of objects. existingNames is
+// set for objects that already exist.
+func (r *NetworkObjectsBulkResource) validateObjects(ctx context.Context, batch networkObjectsBulkValidation, existingNames bool) diag.Diagnostics {
+	if len(batch.objects) == 0 {
+		return nil
+	}
+
+	err := apiclient.ValidateNetworkObjects(ctx, r.client, batch.objects)
+
+	// Rows only identify the object when it was sent alone.
+	if len(batch.names) == 1 {
+		parent := path.Root("objects").AtMapKey(batch.names[0])
+		return objectValidationDiagnostics(err, "Network Object", existingNames, func(field string) path.Path {
+			if field == "name" {
+				return parent
+			}
+			return networkObjectFieldPath(parent, field)
+		}, nil)
+	}
+	return objectValidationDiagnostics(err, "Network Object", existingNames, func(string) path.Path {
+		return path.Root("objects")
+	}, func(row int) string {
+		return fmt.Sprintf("Row %d of the objects %s", row, strings.Join(batch.names, ", "))
+	})
+}
+
+func (r *NetworkObjectsBulkResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan NetworkObjectsBulkResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	planned, diags := networkObjectsBulkItems(ctx, plan.Objects)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ids, err := r.uploadObjects(ctx, planned, slices.Sorted(maps.Keys(planned)))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating Network Objects",
+			"Could not create network objects, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	var lowest int64
+	for _, id := range ids {
+		if lowest == 0 || id < lowest {
+			lowest = id
+		}
+	}
+	plan.ID = types.StringValue(strconv.FormatInt(lowest, 10))
+
+	plan.ObjectIDs, diags = types.MapValueFrom(ctx, types.Int64Type, ids)
+	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *NetworkObjectsBulkResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state NetworkObjectsBulkResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ids := map[string]int64{}
+	resp.Diagnostics.Append(state.ObjectIDs.ElementsAs(ctx, &ids, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	objects, err := apiclient.GetNetworkObjects(ctx, r.client)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading Network Objects",
+			"Could not read network objects: "+err.Error(),
+		)
+		return
+	}
+
+	byID := map[int64]map[string]interface{}{}
+	for _, object := range objects {
+		if id, ok := networkObjectID(object); ok {
+			byID[id] = object
+		}
+	}
+
+	// Objects that were deleted or renamed outside of Terraform are dropped,
+	// so that the next apply creates them again.
+	items := map[string]NetworkObjectsBulkItemModel{}
+	for name, id := range ids {
+		object, ok := byID[id]
+		if !ok || object["name"] != name {
+			delete(ids, name)
+			continue
+		}
+		items[name] = networkObjectsBulkItemFromAPI(object)
+	}
+
+	var diags diag.Diagnostics
+	state.Objects, diags = types.MapValueFrom(ctx, types.ObjectType{AttrTypes: networkObjectsBulkItemAttrTypes}, items)
+	resp.Diagnostics.Append(diags...)
+	state.ObjectIDs, diags = types.MapValueFrom(ctx, types.Int64Type, ids)
+	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *NetworkObjectsBulkResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state NetworkObjectsBulkResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	planned, diags := networkObjectsBulkItems(ctx, plan.Objects)
+	resp.Diagnostics.Append(diags...)
+	current, diags := networkObjectsBulkItems(ctx, state.Objects)
+	resp.Diagnostics.Append(diags...)
+	ids := map[string]int64{}
+	resp.Diagnostics.Append(state.ObjectIDs.ElementsAs(ctx, &ids, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Delete removed objects first, then update changed ones and finally
+	// upload the new ones in one request.
+	for name, id := range ids {
+		if _, ok := planned[name]; ok {
+			continue
+		}
+		err := apiclient.DeleteNetworkObject(ctx, r.client, strconv.FormatInt(id, 10))
+		if err != nil && !apiclient.IsNotFound(err) {
+			resp.Diagnostics.AddError(
+				"Error updating Network Objects",
+				fmt.Sprintf("Could not delete network object %q: %s", name, err),
+			)
+			return
+		}
+		delete(ids, name)
+	}
+
+	var added []string
+	for _, name := range slices.Sorted(maps.Keys(planned)) {
+		item := planned[name]
+		id, ok := ids[name]
+		if !ok {
+			added = append(added, name)
+			continue
+		}
+		if networkObjectsBulkItemEqual(item, current[name]) {
+			continue
+		}
+		payload := networkObjectsBulkPayload(name, item)
+		err := apiclient.PutNetworkObjectDetails(ctx, r.client, strconv.FormatInt(id, 10), payload.Name, payload.Value, payload.Description)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error updating Network Objects",
+				fmt.Sprintf("Could not update network object %q: %s", name, err),
+			)
+			return
+		}
+	}
+
+	if len(added) > 0 {
+		created, err := r.uploadObjects(ctx, planned, added)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error updating Network Objects",
+				"Could not create network objects, unexpected error: "+err.Error(),
+			)
+			return
+		}
+		for name, id := range created {
+			ids[name] = id
+		}
+	}
+
+	plan.ObjectIDs, diags = types.MapValueFrom(ctx, types.Int64Type, ids)
+	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *NetworkObjectsBulkResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state NetworkObjectsBulkResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ids := map[string]int64{}
+	resp.Diagnostics.Append(state.ObjectIDs.ElementsAs(ctx, &ids, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	for _, name := range slices.Sorted(maps.Keys(ids)) {
+		err := apiclient.DeleteNetworkObject(ctx, r.client, strconv.FormatInt(ids[name], 10))
+		if err != nil && !apiclient.IsNotFound(err) {
+			resp.Diagnostics.AddError(
+				"Error deleting Network Objects",
+				fmt.Sprintf("Could not delete network object %q: %s", name, err),
+			)
+		}
+	}
+}
+
+// uploadObjects creates the objects named names through the upload endpoint
+// and returns their IDs by name. The upload response lists the IDs of the new
+// objects but not which ID belongs to which object, so they are matched by
+// name. Names that already exist are rejected before the upload, so that
+// objects not created by this resource are never adopted. If an object cannot
+// be matched, the uploaded objects are deleted again instead of being left
+// unmanaged.
+func (r *NetworkObjectsBulkResource) uploadObjects(ctx context.Context, items map[string]NetworkObjectsBulkItemModel, names []string) (map[string]int64, error) {
+	objects, err := apiclient.GetNetworkObjects(ctx, r.client)
+	if err != nil {
+		return nil, err
+	}
+
+	var existing []string
+	for _, object := range objects {
+		if name, _ := object["name"].(string); slices.Contains(names, name) {
+			existing = append(existing, name)
+		}
+	}
+	if len(existing) > 0 {
+		slices.Sort(existing)
+		return nil, fmt.Errorf("network objects named %s already exist and are not managed by this resource", strings.Join(existing, ", "))
+	}
+
+	payloads := make([]apiclient.NetworkObjectPayload, 0, len(names))
+	for _, name := range names {
+		payloads = append(payloads, networkObjectsBulkPayload(name, items[name]))
+	}
+
+	result, err := apiclient.UploadNetworkObjects(ctx, r.client, payloads)
+	if err != nil {
+		return nil, err
+	}
+
+	objects, err = apiclient.GetNetworkObjects(ctx, r.client)
+	if err != nil {
+		return nil, r.rollbackUpload(ctx, result.ObjectIDs, err)
+	}
+
+	ids := map[string]int64{}
+	for _, object := range objects {
+		name, _ := object["name"].(string)
+		if !slices.Contains(names, name) {
+			continue
+		}
+		if id, ok := networkObjectID(object); ok {
+			ids[name] = id
+		}
+	}
+
+	var missing []string
+	for _, name := range names {
+		id, ok := ids[name]
+		if !ok || (len(result.ObjectIDs) > 0 && !slices.Contains(result.ObjectIDs, id)) {
+			missing = append(missing, name)
+		}
+	}
+	var mismatch error
+	switch {
+	case len(missing) > 0:
+		mismatch = fmt.Errorf("network objects %s were not found among the uploaded objects", strings.Join(missing, ", "))
+	case len(result.ObjectIDs) > len(names):
+		mismatch = fmt.Errorf("the upload created %d network objects instead of %d", len(result.ObjectIDs), len(names))
+	}
+	if mismatch != nil {
+		uploaded := slices.Clone(result.ObjectIDs)
+		for _, id := range ids {
+			if !slices.Contains(uploaded, id) {
+				uploaded = append(uploaded, id)
+			}
+		}
+		return nil, r.rollbackUpload(ctx, uploaded, mismatch)
+	}
+	return ids, nil
+}
+
+// rollbackUpload deletes the objects created by an upload whose result could
+// not be recorded, and returns cause with any deletion errors.
+func (r *NetworkObjectsBulkResource) rollbackUpload(ctx context.Context, ids []int64, cause error) error {
+	errs := []error{cause}
+	for _, id := range ids {
+		err := apiclient.DeleteNetworkObject(ctx, r.client, strconv.FormatInt(id, 10))
+		if err != nil && !apiclient.IsNotFound(err) {
+			errs = append(errs, fmt.Errorf("could not delete uploaded network object %d: %w", id, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func networkObjectsBulkItems(ctx context.Context, objects types.Map) (map[string]NetworkObjectsBulkItemModel, diag.Diagnostics) {
+	items := map[string]NetworkObjectsBulkItemModel{}
+	if objects.IsNull() || objects.IsUnknown() {
+		return items, nil
+	}
+	diags := objects.ElementsAs(ctx, &items, false)
+	return items, diags
+}
+
+func networkObjectsBulkPayload(name string, item NetworkObjectsBulkItemModel) apiclient.NetworkObjectPayload {
+	return apiclient.NetworkObjectPayload{
+		Name:        name,
+		Description: item.Description.ValueString(),
+		Value:       networkObjectValue(item.Type, listStrings(item.Addresses)),
+	}
+}
+
+func networkObjectsBulkItemEqual(a, b NetworkObjectsBulkItemModel) bool {
+	return a.Type.Equal(b.Type) && a.Description.Equal(b.Description) && a.Addresses.Equal(b.Addresses)
+}
+
+func networkObjectsBulkItemFromAPI(object map[string]interface{}) NetworkObjectsBulkItemModel {
+	var item NetworkObjectsBulkItemModel
+	addresses := []attr.Value{}
+	description, _ := object["description"].(string)
+	item.Description = stringOrNull(description)
+	if value, ok := object["value"].(map[string]interface{}); ok {
+		objectType, _ := value["type"].(string)
+		item.Type = types.StringValue(objectType)
+		if values, ok := value["addresses"].([]interface{}); ok {
+			for _, addr := range values {
+				if s, ok := addr.(string); ok {
+					addresses = append(addresses, types.StringValue(s))
+				}
+			}
+		}
+	}
+	item.Addresses = types.ListValueMust(types.StringType, addresses)
+	return item
+}
+
+// listStrings returns the elements of a list of strings. Null and unknown
+// lists have no elements.
+func listStrings(list types.List) []types.String {
+	values := make([]types.String, 0, len(list.Elements()))
+	for _, element := range list.Elements() {
+		if v, ok := element.(types.String); ok {
+			values = append(values, v)
+		}
+	}
+	return values
+}
+
+// networkObjectID returns the ID of a network object decoded from JSON.
+func networkObjectID(object map[string]interface{}) (int64, bool) {
+	switch id := object["id"].(type) {
+	case float64:
+		return int64(id), true
+	case string:
+		v, err := strconv.ParseInt(id, 10, 64)
+		return v, err == nil
+	}
+	return 0, false
+}
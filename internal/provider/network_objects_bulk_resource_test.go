@@ -0,0 +1,189 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"slices"
+	"strconv"
+	"testing"
+
+	"github.com/cisco/terraform-provider-sse/internal/apiclient"
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccNetworkObjectsBulkResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Invalid addresses are rejected at plan time
+			{
+				Config: `
+resource "sse_network_objects_bulk" "test" {
+  objects = {
+    "tf-acc-test-bulk-invalid" = { type = "network", addresses = ["10.0.0.0/99"] }
+  }
+}
+`,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`Invalid Network Object`),
+			},
+			// Create and Read testing
+			{
+				Config: `
+resource "sse_network_objects_bulk" "test" {
+  objects = {
+    "tf-acc-test-bulk-1" = { type = "host", addresses = ["10.1.1.1"] }
+    "tf-acc-test-bulk-2" = { type = "network", addresses = ["10.2.0.0/16"], description = "Acceptance Test" }
+  }
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("sse_network_objects_bulk.test", "objects.%", "2"),
+					resource.TestCheckResourceAttr("sse_network_objects_bulk.test", "object_ids.%", "2"),
+					resource.TestCheckResourceAttrSet("sse_network_objects_bulk.test", "object_ids.tf-acc-test-bulk-1"),
+					resource.TestCheckResourceAttrSet("sse_network_objects_bulk.test", "id"),
+					// The CSV columns of the upload are not defined by the API
+					// specification, so check that every field arrived.
+					testAccCheckNetworkObjectsBulkUploaded(t, "tf-acc-test-bulk-2", "network", []string{"10.2.0.0/16"}, "Acceptance Test"),
+				),
+			},
+			// Update testing: one object changed, one removed and one added
+			{
+				Config: `
+resource "sse_network_objects_bulk" "test" {
+  objects = {
+    "tf-acc-test-bulk-1" = { type = "host", addresses = ["10.1.1.2"] }
+    "tf-acc-test-bulk-3" = { type = "range", addresses = ["10.3.0.1-10.3.0.9"] }
+  }
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("sse_network_objects_bulk.test", "objects.tf-acc-test-bulk-1.addresses.0", "10.1.1.2"),
+					resource.TestCheckResourceAttr("sse_network_objects_bulk.test", "object_ids.%", "2"),
+					resource.TestCheckNoResourceAttr("sse_network_objects_bulk.test", "object_ids.tf-acc-test-bulk-2"),
+					resource.TestCheckResourceAttrSet("sse_network_objects_bulk.test", "object_ids.tf-acc-test-bulk-3"),
+					testAccCheckNetworkObjectsBulkUploaded(t, "tf-acc-test-bulk-3", "range", []string{"10.3.0.1-10.3.0.9"}, ""),
+				),
+			},
+			// Update testing of a description only, the object keeps its name
+			{
+				Config: `
+resource "sse_network_objects_bulk" "test" {
+  objects = {
+    "tf-acc-test-bulk-1" = { type = "host", addresses = ["10.1.1.2"], description = "Updated description" }
+    "tf-acc-test-bulk-3" = { type = "range", addresses = ["10.3.0.1-10.3.0.9"] }
+  }
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("sse_network_objects_bulk.test", "objects.tf-acc-test-bulk-1.description", "Updated description"),
+					testAccCheckNetworkObjectsBulkUploaded(t, "tf-acc-test-bulk-1", "host", []string{"10.1.1.2"}, "Updated description"),
+				),
+			},
+			// Objects that already exist are not adopted
+			{
+				Config: `
+resource "sse_network_object" "existing" {
+  name      = "tf-acc-test-bulk-existing"
+  type      = "host"
+  addresses = ["10.4.4.4"]
+}
+
+resource "sse_network_objects_bulk" "test" {
+  objects = {
+    "tf-acc-test-bulk-1"        = { type = "host", addresses = ["10.1.1.2"] }
+    "tf-acc-test-bulk-3"        = { type = "range", addresses = ["10.3.0.1-10.3.0.9"] }
+    "tf-acc-test-bulk-existing" = { type = "host", addresses = ["10.4.4.4"] }
+  }
+
+  depends_on = [sse_network_object.existing]
+}
+`,
+				ExpectError: regexp.MustCompile(`already exist and are not managed`),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+// testAccCheckNetworkObjectsBulkUploaded checks the network object the bulk
+// resource created for name against the API.
+func testAccCheckNetworkObjectsBulkUploaded(t *testing.T, name, objectType string, addresses []string, description string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources["sse_network_objects_bulk.test"]
+		if !ok {
+			return fmt.Errorf("resource sse_network_objects_bulk.test not found in state")
+		}
+
+		id, err := strconv.ParseInt(rs.Primary.Attributes["object_ids."+name], 10, 64)
+		if err != nil {
+			return fmt.Errorf("invalid ID of network object %q: %w", name, err)
+		}
+
+		objects, err := apiclient.GetNetworkObjects(context.Background(), testAccAPIClient(t))
+		if err != nil {
+			return err
+		}
+
+		for _, object := range objects {
+			if objectID, ok := networkObjectID(object); !ok || objectID != id {
+				continue
+			}
+			item := networkObjectsBulkItemFromAPI(object)
+			if object["name"] != name || item.Type.ValueString() != objectType || item.Description.ValueString() != description ||
+				!slices.Equal(stringValues(listStrings(item.Addresses)), addresses) {
+				return fmt.Errorf("network object %d does not match the upload: %v", id, object)
+			}
+			return nil
+		}
+		return fmt.Errorf("network object %d not found", id)
+	}
+}
+
+// TestNetworkObjectsBulkResourceModifyPlanUnknownAddresses checks that objects
+// whose addresses are only known after the apply are planned without errors.
+func TestNetworkObjectsBulkResourceModifyPlanUnknownAddresses(t *testing.T) {
+	ctx := context.Background()
+	r := NewNetworkObjectsBulkResource()
+
+	var schemaResp fwresource.SchemaResponse
+	r.Schema(ctx, fwresource.SchemaRequest{}, &schemaResp)
+
+	itemType := tftypes.Object{AttributeTypes: map[string]tftypes.Type{
+		"type":        tftypes.String,
+		"addresses":   tftypes.List{ElementType: tftypes.String},
+		"description": tftypes.String,
+	}}
+	raw := tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), map[string]tftypes.Value{
+		"id": tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+		"objects": tftypes.NewValue(tftypes.Map{ElementType: itemType}, map[string]tftypes.Value{
+			"tf-unit-test-web": tftypes.NewValue(itemType, map[string]tftypes.Value{
+				"type":        tftypes.NewValue(tftypes.String, "host"),
+				"addresses":   tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, tftypes.UnknownValue),
+				"description": tftypes.NewValue(tftypes.String, nil),
+			}),
+		}),
+		"object_ids": tftypes.NewValue(tftypes.Map{ElementType: tftypes.Number}, tftypes.UnknownValue),
+	})
+
+	plan := tfsdk.Plan{Schema: schemaResp.Schema, Raw: raw}
+	req := fwresource.ModifyPlanRequest{
+		Plan:  plan,
+		State: tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil)},
+	}
+	resp := fwresource.ModifyPlanResponse{Plan: plan}
+
+	r.(fwresource.ResourceWithModifyPlan).ModifyPlan(ctx, req, &resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+	}
+}
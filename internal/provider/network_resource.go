@@ -0,0 +1,285 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/netip"
+	"strconv"
+
+	"github.com/cisco/terraform-provider-sse/internal/apiclient"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ resource.Resource = &NetworkResource{}
+var _ resource.ResourceWithImportState = &NetworkResource{}
+var _ resource.ResourceWithValidateConfig = &NetworkResource{}
+
+func NewNetworkResource() resource.Resource {
+	return &NetworkResource{}
+}
+
+type NetworkResource struct {
+	client *apiclient.APIClient
+}
+
+type NetworkResourceModel struct {
+	ID           types.Int64  `tfsdk:"id"`
+	Name         types.String `tfsdk:"name"`
+	IPAddress    types.String `tfsdk:"ip_address"`
+	PrefixLength types.Int64  `tfsdk:"prefix_length"`
+	IsDynamic    types.Bool   `tfsdk:"is_dynamic"`
+	Status       types.String `tfsdk:"status"`
+	IsVerified   types.Bool   `tfsdk:"is_verified"`
+}
+
+func (r *NetworkResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_network"
+}
+
+func (r *NetworkResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a Network. A network registers the public egress IP addresses of a location as an identity that can be used in access rules.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				Computed:    true,
+				Description: "The origin ID of the Network. This is the identity ID to use in `umbrella.source.identity_ids` access rule conditions.",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:    true,
+				Description: "The name of the Network (1-50 characters).",
+			},
+			"ip_address": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "The public IP address of the Network. Required unless `is_dynamic` is `true`.",
+			},
+			"prefix_length": schema.Int64Attribute{
+				Required:    true,
+				Description: "The prefix length of the Network, between 29 and 32.",
+			},
+			"is_dynamic": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+				Description: "Whether the Network has a dynamic IP address. Defaults to `false`.",
+			},
+			"status": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString("OPEN"),
+				Description: "The status of the Network. Valid values are `OPEN` and `CLOSED`. Defaults to `OPEN`.",
+			},
+			"is_verified": schema.BoolAttribute{
+				Computed:    true,
+				Description: "Whether the Network is verified.",
+			},
+		},
+	}
+}
+
+func (r *NetworkResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config NetworkResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !config.IPAddress.IsNull() && !config.IPAddress.IsUnknown() {
+		if addr, err := netip.ParseAddr(config.IPAddress.ValueString()); err != nil || !addr.Is4() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("ip_address"),
+				"Invalid IP Address",
+				fmt.Sprintf("%q is not a valid IPv4 address.", config.IPAddress.ValueString()),
+			)
+		}
+	}
+
+	if config.IPAddress.IsNull() && !config.IsDynamic.IsUnknown() && !config.IsDynamic.ValueBool() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("ip_address"),
+			"Missing IP Address",
+			"ip_address must be set unless is_dynamic is true.",
+		)
+	}
+
+	if !config.PrefixLength.IsNull() && !config.PrefixLength.IsUnknown() {
+		if length := config.PrefixLength.ValueInt64(); length < 29 || length > 32 {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("prefix_length"),
+				"Invalid Prefix Length",
+				fmt.Sprintf("The prefix length must be between 29 and 32, got %d.", length),
+			)
+		}
+	}
+
+	if !config.Status.IsNull() && !config.Status.IsUnknown() {
+		if status := config.Status.ValueString(); status != "OPEN" && status != "CLOSED" {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("status"),
+				"Invalid Status",
+				fmt.Sprintf("The status must be OPEN or CLOSED, got %q.", status),
+			)
+		}
+	}
+}
+
+func (r *NetworkResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*apiclient.APIClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *apiclient.APIClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *NetworkResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan NetworkResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	network, err := apiclient.CreateNetwork(ctx, r.client, networkPayload(plan))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating Network",
+			"Could not create network, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	mapNetworkToModel(network, &plan)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *NetworkResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state NetworkResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := state.ID.ValueInt64()
+
+	network, err := apiclient.GetNetwork(ctx, r.client, id)
+	if removeMissingResource(ctx, resp, err, "Network", strconv.FormatInt(id, 10)) {
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading Network",
+			"Could not read network ID "+strconv.FormatInt(id, 10)+": "+err.Error(),
+		)
+		return
+	}
+
+	mapNetworkToModel(network, &state)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *NetworkResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan NetworkResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := plan.ID.ValueInt64()
+
+	network, err := apiclient.UpdateNetwork(ctx, r.client, id, networkPayload(plan))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating Network",
+			"Could not update network ID "+strconv.FormatInt(id, 10)+": "+err.Error(),
+		)
+		return
+	}
+
+	mapNetworkToModel(network, &plan)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *NetworkResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state NetworkResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := state.ID.ValueInt64()
+
+	err := apiclient.DeleteNetwork(ctx, r.client, id)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error deleting Network",
+			"Could not delete network ID "+strconv.FormatInt(id, 10)+": "+err.Error(),
+		)
+		return
+	}
+}
+
+func (r *NetworkResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	id, err := strconv.ParseInt(req.ID, 10, 64)
+	if err != nil {
+		// Not a number, try to find by name
+		id, err = apiclient.GetNetworkIDByName(ctx, r.client, req.ID)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error importing Network",
+				fmt.Sprintf("Could not find network with name %q: %s", req.ID, err),
+			)
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+}
+
+func networkPayload(model NetworkResourceModel) apiclient.NetworkPayload {
+	return apiclient.NetworkPayload{
+		Name:         model.Name.ValueString(),
+		IPAddress:    model.IPAddress.ValueString(),
+		PrefixLength: int(model.PrefixLength.ValueInt64()),
+		IsDynamic:    model.IsDynamic.ValueBool(),
+		Status:       model.Status.ValueString(),
+	}
+}
+
+func mapNetworkToModel(network *apiclient.Network, model *NetworkResourceModel) {
+	model.ID = types.Int64Value(network.OriginID)
+	model.Name = types.StringValue(network.Name)
+	model.IPAddress = types.StringValue(network.IPAddress)
+	model.PrefixLength = types.Int64Value(int64(network.PrefixLength))
+	model.IsDynamic = types.BoolValue(network.IsDynamic)
+	model.Status = types.StringValue(network.Status)
+	model.IsVerified = types.BoolValue(network.IsVerified)
+}
@@ -0,0 +1,81 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccNetworkResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccNetworkResourceConfig("tf-acc-test-network", 32),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("sse_network.test", "name", "tf-acc-test-network"),
+					resource.TestCheckResourceAttr("sse_network.test", "ip_address", "198.51.100.8"),
+					resource.TestCheckResourceAttr("sse_network.test", "prefix_length", "32"),
+					resource.TestCheckResourceAttr("sse_network.test", "is_dynamic", "false"),
+					resource.TestCheckResourceAttr("sse_network.test", "status", "OPEN"),
+					resource.TestCheckResourceAttrSet("sse_network.test", "id"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "sse_network.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// ImportState testing by name
+			{
+				ResourceName:      "sse_network.test",
+				ImportState:       true,
+				ImportStateId:     "tf-acc-test-network",
+				ImportStateVerify: true,
+			},
+			// Update testing
+			{
+				Config: testAccNetworkResourceConfig("tf-acc-test-network-updated", 30),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("sse_network.test", "name", "tf-acc-test-network-updated"),
+					resource.TestCheckResourceAttr("sse_network.test", "prefix_length", "30"),
+				),
+			},
+			// Data sources
+			{
+				Config: testAccNetworkResourceConfig("tf-acc-test-network-updated", 30) + `
+data "sse_network" "by_name" {
+  name = sse_network.test.name
+}
+
+data "sse_networks" "all" {
+  depends_on = [sse_network.test]
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.sse_network.by_name", "id", "sse_network.test", "id"),
+					resource.TestCheckResourceAttrPair("data.sse_network.by_name", "ip_address", "sse_network.test", "ip_address"),
+					resource.TestCheckResourceAttrSet("data.sse_networks.all", "networks.#"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccNetworkResourceConfig(name string, prefixLength int) string {
+	return fmt.Sprintf(`
+resource "sse_network" "test" {
+  name          = %[1]q
+  ip_address    = "198.51.100.8"
+  prefix_length = %[2]d
+}
+`, name, prefixLength)
+}
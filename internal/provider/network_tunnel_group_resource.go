@@ -0,0 +1,636 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/netip"
+	"slices"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/cisco/terraform-provider-sse/internal/apiclient"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+var _ resource.Resource = &NetworkTunnelGroupResource{}
+var _ resource.ResourceWithImportState = &NetworkTunnelGroupResource{}
+var _ resource.ResourceWithValidateConfig = &NetworkTunnelGroupResource{}
+var _ resource.ResourceWithModifyPlan = &NetworkTunnelGroupResource{}
+
+// networkTunnelDeviceTypes are the device types accepted by the Network Tunnel Groups API.
+var networkTunnelDeviceTypes = []string{
+	"ASA", "AWS S2S VPN", "AZURE S2S VPN", "FTD", "ISR", "Meraki MX", "Viptela cEdge", "Viptela vEdge", "other",
+}
+
+func NewNetworkTunnelGroupResource() resource.Resource {
+	return &NetworkTunnelGroupResource{}
+}
+
+type NetworkTunnelGroupResource struct {
+	client *apiclient.APIClient
+}
+
+type NetworkTunnelGroupResourceModel struct {
+	ID           types.Int64  `tfsdk:"id"`
+	Name         types.String `tfsdk:"name"`
+	Region       types.String `tfsdk:"region"`
+	DeviceType   types.String `tfsdk:"device_type"`
+	AuthIDPrefix types.String `tfsdk:"auth_id_prefix"`
+	Passphrase   types.String `tfsdk:"passphrase"`
+	Routing      types.Object `tfsdk:"routing"`
+	Status       types.String `tfsdk:"status"`
+	Hubs         types.List   `tfsdk:"hubs"`
+}
+
+type NetworkTunnelGroupRoutingModel struct {
+	Type             types.String `tfsdk:"type"`
+	NetworkCIDRs     types.Set    `tfsdk:"network_cidrs"`
+	ASNumber         types.String `tfsdk:"as_number"`
+	BGPHopCount      types.Int64  `tfsdk:"bgp_hop_count"`
+	BGPNeighborCIDRs types.Set    `tfsdk:"bgp_neighbor_cidrs"`
+}
+
+type NetworkTunnelHubModel struct {
+	ID         types.Int64  `tfsdk:"id"`
+	IsPrimary  types.Bool   `tfsdk:"is_primary"`
+	Datacenter types.String `tfsdk:"datacenter"`
+	IPAddress  types.String `tfsdk:"ip_address"`
+	IKEID      types.String `tfsdk:"ike_id"`
+}
+
+var networkTunnelGroupRoutingAttrTypes = map[string]attr.Type{
+	"type":               types.StringType,
+	"network_cidrs":      types.SetType{ElemType: types.StringType},
+	"as_number":          types.StringType,
+	"bgp_hop_count":      types.Int64Type,
+	"bgp_neighbor_cidrs": types.SetType{ElemType: types.StringType},
+}
+
+var networkTunnelHubAttrTypes = map[string]attr.Type{
+	"id":         types.Int64Type,
+	"is_primary": types.BoolType,
+	"datacenter": types.StringType,
+	"ip_address": types.StringType,
+	"ike_id":     types.StringType,
+}
+
+func (r *NetworkTunnelGroupResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_network_tunnel_group"
+}
+
+func (r *NetworkTunnelGroupResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a Network Tunnel Group. Secure Access provisions a primary and a secondary hub for the group; their IP addresses and IKE IDs are exported so IPsec tunnels can be configured on the peer device.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				Computed:    true,
+				Description: "The ID of the Network Tunnel Group.",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:    true,
+				Description: "The name of the Network Tunnel Group (1-50 characters, letters, numbers, spaces and hyphens).",
+			},
+			"region": schema.StringAttribute{
+				Required:    true,
+				Description: "The region used to select the primary and secondary data centers of the hubs (e.g., us-east-1). Checked at plan time against the regions listed by the `sse_regions` data source.",
+			},
+			"device_type": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  stringdefault.StaticString("other"),
+				Description: "The type of device that establishes the tunnels. Valid values are `ASA`, `AWS S2S VPN`, `AZURE S2S VPN`, `FTD`, `ISR`, " +
+					"`Meraki MX`, `Viptela cEdge`, `Viptela vEdge` and `other`. Defaults to `other`. Changing this forces a new resource to be created.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"auth_id_prefix": schema.StringAttribute{
+				Required: true,
+				Description: "The prefix used to generate the IKE IDs of the tunnels (8-100 characters, letters, numbers, `.`, `_` and `-`). " +
+					"The API does not return the prefix, so it is not refreshed from Secure Access. After an import the configured value is stored without being sent.",
+			},
+			"passphrase": schema.StringAttribute{
+				Required:  true,
+				Sensitive: true,
+				Description: "The pre-shared key of the primary and secondary tunnels (16-64 letters and numbers, with at least one uppercase letter, " +
+					"one lowercase letter and one number). The API never returns the passphrase, so it is not refreshed from Secure Access. After an import the configured value is stored without being sent.",
+			},
+			"routing": schema.SingleNestedAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "The routing of the Network Tunnel Group. If not set, the routing chosen by Secure Access is kept.",
+				PlanModifiers: []planmodifier.Object{
+					objectplanmodifier.UseStateForUnknown(),
+				},
+				Attributes: map[string]schema.Attribute{
+					"type": schema.StringAttribute{
+						Required:    true,
+						Description: "The routing type. Valid values are `static` and `bgp`.",
+					},
+					"network_cidrs": schema.SetAttribute{
+						Optional:    true,
+						ElementType: types.StringType,
+						Description: "The network CIDRs routed through the tunnels. Required for `static` routing.",
+					},
+					"as_number": schema.StringAttribute{
+						Optional:    true,
+						Description: "The BGP autonomous system number of the peer, between 0 and 65536. Required for `bgp` routing.",
+					},
+					"bgp_hop_count": schema.Int64Attribute{
+						Optional:    true,
+						Description: "The maximum number of hops to the BGP neighbors, between 1 and 64. Requires `bgp_neighbor_cidrs`.",
+					},
+					"bgp_neighbor_cidrs": schema.SetAttribute{
+						Optional:    true,
+						ElementType: types.StringType,
+						Description: "The CIDRs of the BGP neighbors allowed to peer with the hubs (at most 20).",
+					},
+				},
+			},
+			"status": schema.StringAttribute{
+				Computed:    true,
+				Description: "The status of the Network Tunnel Group (`connected`, `disconnected` or `warning`).",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"hubs": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "The hubs the tunnels of the group terminate on.",
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.UseStateForUnknown(),
+				},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.Int64Attribute{
+							Computed:    true,
+							Description: "The ID of the hub.",
+						},
+						"is_primary": schema.BoolAttribute{
+							Computed:    true,
+							Description: "Whether the hub is in the primary data center.",
+						},
+						"datacenter": schema.StringAttribute{
+							Computed:    true,
+							Description: "The name of the data center of the hub.",
+						},
+						"ip_address": schema.StringAttribute{
+							Computed:    true,
+							Description: "The IP address of the hub, used as the tunnel peer address.",
+						},
+						"ike_id": schema.StringAttribute{
+							Computed:    true,
+							Description: "The IKE ID the peer device authenticates the tunnel to this hub with.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *NetworkTunnelGroupResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config NetworkTunnelGroupResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !config.Name.IsNull() && !config.Name.IsUnknown() {
+		name := config.Name.ValueString()
+		if len(name) < 1 || len(name) > 50 || strings.ContainsFunc(name, func(c rune) bool {
+			return !isASCIIAlphanumeric(c) && c != ' ' && c != '-'
+		}) {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("name"),
+				"Invalid Network Tunnel Group Name",
+				fmt.Sprintf("The name must be 1-50 characters long and contain only letters, numbers, spaces and hyphens, got %q.", name),
+			)
+		}
+	}
+
+	if !config.DeviceType.IsNull() && !config.DeviceType.IsUnknown() {
+		if deviceType := config.DeviceType.ValueString(); !slices.Contains(networkTunnelDeviceTypes, deviceType) {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("device_type"),
+				"Invalid Device Type",
+				fmt.Sprintf("The device type must be one of %s, got %q.", strings.Join(networkTunnelDeviceTypes, ", "), deviceType),
+			)
+		}
+	}
+
+	if !config.AuthIDPrefix.IsNull() && !config.AuthIDPrefix.IsUnknown() {
+		prefix := config.AuthIDPrefix.ValueString()
+		if len(prefix) < 8 || len(prefix) > 100 || strings.ContainsFunc(prefix, func(c rune) bool {
+			return !isASCIIAlphanumeric(c) && c != '.' && c != '_' && c != '-'
+		}) {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("auth_id_prefix"),
+				"Invalid Auth ID Prefix",
+				"The auth ID prefix must be 8-100 characters long and contain only letters, numbers, '.', '_' and '-'.",
+			)
+		}
+	}
+
+	if !config.Passphrase.IsNull() && !config.Passphrase.IsUnknown() {
+		passphrase := config.Passphrase.ValueString()
+		if len(passphrase) < 16 || len(passphrase) > 64 ||
+			strings.ContainsFunc(passphrase, func(c rune) bool { return !isASCIIAlphanumeric(c) }) ||
+			!strings.ContainsFunc(passphrase, unicode.IsUpper) ||
+			!strings.ContainsFunc(passphrase, unicode.IsLower) ||
+			!strings.ContainsFunc(passphrase, unicode.IsDigit) {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("passphrase"),
+				"Invalid Passphrase",
+				"The passphrase must be 16-64 letters and numbers long and contain at least one uppercase letter, one lowercase letter and one number.",
+			)
+		}
+	}
+
+	if config.Routing.IsNull() || config.Routing.IsUnknown() {
+		return
+	}
+
+	var routing NetworkTunnelGroupRoutingModel
+	resp.Diagnostics.Append(config.Routing.As(ctx, &routing, basetypes.ObjectAsOptions{})...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	switch routing.Type.ValueString() {
+	case "static":
+		if routing.NetworkCIDRs.IsNull() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("routing").AtName("network_cidrs"),
+				"Missing Network CIDRs",
+				"network_cidrs must be set for static routing.",
+			)
+		}
+	case "bgp":
+		if !routing.ASNumber.IsNull() && !routing.ASNumber.IsUnknown() {
+			if asNumber, err := strconv.Atoi(routing.ASNumber.ValueString()); err != nil || asNumber < 0 || asNumber > 65536 {
+				resp.Diagnostics.AddAttributeError(
+					path.Root("routing").AtName("as_number"),
+					"Invalid AS Number",
+					fmt.Sprintf("The AS number must be an integer between 0 and 65536, got %q.", routing.ASNumber.ValueString()),
+				)
+			}
+		} else if routing.ASNumber.IsNull() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("routing").AtName("as_number"),
+				"Missing AS Number",
+				"as_number must be set for bgp routing.",
+			)
+		}
+		if !routing.BGPHopCount.IsNull() && !routing.BGPHopCount.IsUnknown() {
+			if count := routing.BGPHopCount.ValueInt64(); count < 1 || count > 64 {
+				resp.Diagnostics.AddAttributeError(
+					path.Root("routing").AtName("bgp_hop_count"),
+					"Invalid BGP Hop Count",
+					fmt.Sprintf("The BGP hop count must be between 1 and 64, got %d.", count),
+				)
+			}
+			if routing.BGPNeighborCIDRs.IsNull() {
+				resp.Diagnostics.AddAttributeError(
+					path.Root("routing").AtName("bgp_neighbor_cidrs"),
+					"Missing BGP Neighbor CIDRs",
+					"bgp_neighbor_cidrs must be set when bgp_hop_count is set.",
+				)
+			}
+		}
+	default:
+		if !routing.Type.IsUnknown() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("routing").AtName("type"),
+				"Invalid Routing Type",
+				fmt.Sprintf("The routing type must be static or bgp, got %q.", routing.Type.ValueString()),
+			)
+		}
+	}
+
+	for name, cidrs := range map[string]types.Set{"network_cidrs": routing.NetworkCIDRs, "bgp_neighbor_cidrs": routing.BGPNeighborCIDRs} {
+		for _, element := range cidrs.Elements() {
+			cidr, ok := element.(types.String)
+			if !ok || cidr.IsUnknown() {
+				continue
+			}
+			if _, err := netip.ParsePrefix(cidr.ValueString()); err != nil {
+				resp.Diagnostics.AddAttributeError(
+					path.Root("routing").AtName(name),
+					"Invalid CIDR",
+					fmt.Sprintf("%q is not a valid CIDR.", cidr.ValueString()),
+				)
+			}
+		}
+	}
+}
+
+func (r *NetworkTunnelGroupResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*apiclient.APIClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *apiclient.APIClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *NetworkTunnelGroupResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to check when destroying
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan, state NetworkTunnelGroupResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if !req.State.Raw.IsNull() {
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !plan.Region.Equal(state.Region) {
+		resp.Diagnostics.Append(validateRegion(ctx, r.client, path.Root("region"), plan.Region)...)
+	}
+
+	// The hubs follow the region and the auth ID prefix, so they are only
+	// kept from the state while neither changes. A null prefix was imported
+	// and is adopted without an update, see Update.
+	prefixChanged := !state.AuthIDPrefix.IsNull() && !plan.AuthIDPrefix.Equal(state.AuthIDPrefix)
+	if !req.State.Raw.IsNull() && (!plan.Region.Equal(state.Region) || prefixChanged) {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("hubs"), types.ListUnknown(types.ObjectType{AttrTypes: networkTunnelHubAttrTypes}))...)
+	}
+}
+
+func (r *NetworkTunnelGroupResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan NetworkTunnelGroupResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	routing, diags := networkTunnelGroupRoutingPayload(ctx, plan.Routing)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	group, err := apiclient.CreateNetworkTunnelGroup(ctx, r.client, apiclient.NetworkTunnelGroupPayload{
+		Name:         plan.Name.ValueString(),
+		Region:       plan.Region.ValueString(),
+		DeviceType:   plan.DeviceType.ValueString(),
+		AuthIDPrefix: plan.AuthIDPrefix.ValueString(),
+		Passphrase:   plan.Passphrase.ValueString(),
+		Routing:      routing,
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating Network Tunnel Group",
+			"Could not create network tunnel group, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(mapNetworkTunnelGroupToModel(ctx, group, &plan)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *NetworkTunnelGroupResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state NetworkTunnelGroupResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := state.ID.ValueInt64()
+
+	group, err := apiclient.GetNetworkTunnelGroup(ctx, r.client, id)
+	if removeMissingResource(ctx, resp, err, "Network tunnel group", strconv.FormatInt(id, 10)) {
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading Network Tunnel Group",
+			"Could not read network tunnel group ID "+strconv.FormatInt(id, 10)+": "+err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(mapNetworkTunnelGroupToModel(ctx, group, &state)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *NetworkTunnelGroupResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state NetworkTunnelGroupResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := state.ID.ValueInt64()
+
+	// The API only supports replacing individual properties, so send the ones that changed.
+	// The auth ID prefix and the passphrase are never read back and are null
+	// after an import. The configured values are adopted as they are instead
+	// of being sent, which would rotate the keys of live tunnels.
+	var patches []apiclient.NetworkTunnelGroupPatch
+	for _, field := range []struct {
+		path        string
+		plan, state types.String
+		adopt       bool
+	}{
+		{"/name", plan.Name, state.Name, false},
+		{"/region", plan.Region, state.Region, false},
+		{"/authIdPrefix", plan.AuthIDPrefix, state.AuthIDPrefix, true},
+		{"/passphrase", plan.Passphrase, state.Passphrase, true},
+	} {
+		if field.adopt && field.state.IsNull() {
+			continue
+		}
+		if !field.plan.Equal(field.state) {
+			patches = append(patches, apiclient.NetworkTunnelGroupPatch{Op: "replace", Path: field.path, Value: field.plan.ValueString()})
+		}
+	}
+
+	if !plan.Routing.IsNull() && !plan.Routing.IsUnknown() && !plan.Routing.Equal(state.Routing) {
+		routing, diags := networkTunnelGroupRoutingPayload(ctx, plan.Routing)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		patches = append(patches, apiclient.NetworkTunnelGroupPatch{Op: "replace", Path: "/routing", Value: routing})
+	}
+
+	var group *apiclient.NetworkTunnelGroup
+	var err error
+	if len(patches) == 0 {
+		group, err = apiclient.GetNetworkTunnelGroup(ctx, r.client, id)
+	} else {
+		group, err = apiclient.UpdateNetworkTunnelGroup(ctx, r.client, id, patches)
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating Network Tunnel Group",
+			"Could not update network tunnel group ID "+strconv.FormatInt(id, 10)+": "+err.Error(),
+		)
+		return
+	}
+
+	// The status follows the tunnels, not the update. Keep the planned value
+	// so that the result matches the plan; the next refresh reads it again.
+	status := plan.Status
+	resp.Diagnostics.Append(mapNetworkTunnelGroupToModel(ctx, group, &plan)...)
+	if !status.IsUnknown() {
+		plan.Status = status
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *NetworkTunnelGroupResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state NetworkTunnelGroupResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := state.ID.ValueInt64()
+
+	err := apiclient.DeleteNetworkTunnelGroup(ctx, r.client, id)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error deleting Network Tunnel Group",
+			"Could not delete network tunnel group ID "+strconv.FormatInt(id, 10)+": "+err.Error(),
+		)
+		return
+	}
+}
+
+func (r *NetworkTunnelGroupResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	id, err := strconv.ParseInt(req.ID, 10, 64)
+	if err != nil {
+		// Not a number, try to find by name
+		id, err = apiclient.GetNetworkTunnelGroupIDByName(ctx, r.client, req.ID)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error importing Network Tunnel Group",
+				fmt.Sprintf("Could not find network tunnel group with name %q: %s", req.ID, err),
+			)
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+}
+
+func networkTunnelGroupRoutingPayload(ctx context.Context, value types.Object) (*apiclient.NetworkTunnelGroupRouting, diag.Diagnostics) {
+	if value.IsNull() || value.IsUnknown() {
+		return nil, nil
+	}
+
+	var model NetworkTunnelGroupRoutingModel
+	diags := value.As(ctx, &model, basetypes.ObjectAsOptions{})
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	routing := &apiclient.NetworkTunnelGroupRouting{
+		Type: model.Type.ValueString(),
+		Data: apiclient.NetworkTunnelGroupRoutingData{
+			ASNumber:    model.ASNumber.ValueString(),
+			BGPHopCount: int(model.BGPHopCount.ValueInt64()),
+		},
+	}
+	diags.Append(model.NetworkCIDRs.ElementsAs(ctx, &routing.Data.NetworkCIDRs, false)...)
+	diags.Append(model.BGPNeighborCIDRs.ElementsAs(ctx, &routing.Data.BGPNeighborCIDRs, false)...)
+
+	return routing, diags
+}
+
+func mapNetworkTunnelGroupToModel(ctx context.Context, group *apiclient.NetworkTunnelGroup, model *NetworkTunnelGroupResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	model.ID = types.Int64Value(group.ID)
+	model.Name = types.StringValue(group.Name)
+	model.Region = types.StringValue(group.Region)
+	model.DeviceType = types.StringValue(group.DeviceType)
+	model.Status = types.StringValue(group.Status)
+
+	hubs := []NetworkTunnelHubModel{}
+	for _, hub := range group.Hubs {
+		hubs = append(hubs, NetworkTunnelHubModel{
+			ID:         types.Int64Value(hub.ID),
+			IsPrimary:  types.BoolValue(hub.IsPrimary),
+			Datacenter: types.StringValue(hub.Datacenter.Name),
+			IPAddress:  types.StringValue(hub.Datacenter.IP),
+			IKEID:      types.StringValue(hub.AuthID),
+		})
+	}
+
+	var d diag.Diagnostics
+	model.Hubs, d = types.ListValueFrom(ctx, types.ObjectType{AttrTypes: networkTunnelHubAttrTypes}, hubs)
+	diags.Append(d...)
+
+	if group.Routing == nil {
+		model.Routing = types.ObjectNull(networkTunnelGroupRoutingAttrTypes)
+		return diags
+	}
+
+	routing := NetworkTunnelGroupRoutingModel{
+		Type:             types.StringValue(group.Routing.Type),
+		NetworkCIDRs:     types.SetNull(types.StringType),
+		ASNumber:         stringOrNull(group.Routing.Data.ASNumber),
+		BGPHopCount:      int64OrNull(int64(group.Routing.Data.BGPHopCount)),
+		BGPNeighborCIDRs: types.SetNull(types.StringType),
+	}
+	if len(group.Routing.Data.NetworkCIDRs) > 0 {
+		routing.NetworkCIDRs, d = types.SetValueFrom(ctx, types.StringType, group.Routing.Data.NetworkCIDRs)
+		diags.Append(d...)
+	}
+	if len(group.Routing.Data.BGPNeighborCIDRs) > 0 {
+		routing.BGPNeighborCIDRs, d = types.SetValueFrom(ctx, types.StringType, group.Routing.Data.BGPNeighborCIDRs)
+		diags.Append(d...)
+	}
+
+	model.Routing, d = types.ObjectValueFrom(ctx, networkTunnelGroupRoutingAttrTypes, routing)
+	diags.Append(d...)
+
+	return diags
+}
+
+// isASCIIAlphanumeric reports whether c is an ASCII letter or digit.
+func isASCIIAlphanumeric(c rune) bool {
+	return c < unicode.MaxASCII && (unicode.IsLetter(c) || unicode.IsDigit(c))
+}
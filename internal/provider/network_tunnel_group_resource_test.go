@@ -0,0 +1,75 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccNetworkTunnelGroupResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccNetworkTunnelGroupResourceConfig("tf-acc-test-tunnel-group", "10.20.0.0/16"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("sse_network_tunnel_group.test", "name", "tf-acc-test-tunnel-group"),
+					resource.TestCheckResourceAttr("sse_network_tunnel_group.test", "region", "us-east-1"),
+					resource.TestCheckResourceAttr("sse_network_tunnel_group.test", "device_type", "other"),
+					resource.TestCheckResourceAttr("sse_network_tunnel_group.test", "routing.type", "static"),
+					resource.TestCheckResourceAttr("sse_network_tunnel_group.test", "routing.network_cidrs.#", "1"),
+					resource.TestCheckResourceAttrSet("sse_network_tunnel_group.test", "id"),
+					resource.TestCheckResourceAttr("sse_network_tunnel_group.test", "hubs.#", "2"),
+					resource.TestCheckResourceAttrSet("sse_network_tunnel_group.test", "hubs.0.ip_address"),
+					resource.TestCheckResourceAttrSet("sse_network_tunnel_group.test", "hubs.0.ike_id"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:            "sse_network_tunnel_group.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"passphrase", "auth_id_prefix", "status"},
+			},
+			// ImportState testing by name
+			{
+				ResourceName:            "sse_network_tunnel_group.test",
+				ImportState:             true,
+				ImportStateId:           "tf-acc-test-tunnel-group",
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"passphrase", "auth_id_prefix", "status"},
+			},
+			// Update testing
+			{
+				Config: testAccNetworkTunnelGroupResourceConfig("tf-acc-test-tunnel-group-updated", "10.30.0.0/16"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("sse_network_tunnel_group.test", "name", "tf-acc-test-tunnel-group-updated"),
+					resource.TestCheckTypeSetElemAttr("sse_network_tunnel_group.test", "routing.network_cidrs.*", "10.30.0.0/16"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccNetworkTunnelGroupResourceConfig(name, cidr string) string {
+	return fmt.Sprintf(`
+resource "sse_network_tunnel_group" "test" {
+  name           = %[1]q
+  region         = "us-east-1"
+  auth_id_prefix = "tfacctesttunnelgroup"
+  passphrase     = "TfAccTestPassphrase123"
+
+  routing = {
+    type          = "static"
+    network_cidrs = [%[2]q]
+  }
+}
+`, name, cidr)
+}
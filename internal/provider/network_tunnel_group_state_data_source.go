@@ -0,0 +1,285 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cisco/terraform-provider-sse/internal/apiclient"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &NetworkTunnelGroupStateDataSource{}
+
+func NewNetworkTunnelGroupStateDataSource() datasource.DataSource {
+	return &NetworkTunnelGroupStateDataSource{}
+}
+
+type NetworkTunnelGroupStateDataSource struct {
+	client *apiclient.APIClient
+}
+
+type NetworkTunnelGroupStateDataSourceModel struct {
+	ID                  types.Int64                    `tfsdk:"id"`
+	NetworkTunnelGroups []NetworkTunnelGroupStateModel `tfsdk:"network_tunnel_groups"`
+	Peers               []NetworkTunnelStateModel      `tfsdk:"peers"`
+}
+
+type NetworkTunnelGroupStateModel struct {
+	ID     types.Int64                  `tfsdk:"id"`
+	Name   types.String                 `tfsdk:"name"`
+	Status types.String                 `tfsdk:"status"`
+	Hubs   []NetworkTunnelHubStateModel `tfsdk:"hubs"`
+}
+
+type NetworkTunnelHubStateModel struct {
+	ID         types.Int64               `tfsdk:"id"`
+	IsPrimary  types.Bool                `tfsdk:"is_primary"`
+	Datacenter types.String              `tfsdk:"datacenter"`
+	Status     types.String              `tfsdk:"status"`
+	StatusTime types.String              `tfsdk:"status_time"`
+	Tunnels    []NetworkTunnelStateModel `tfsdk:"tunnels"`
+}
+
+type NetworkTunnelStateModel struct {
+	PeerID     types.String `tfsdk:"peer_id"`
+	PeerIP     types.String `tfsdk:"peer_ip"`
+	PeerPort   types.String `tfsdk:"peer_port"`
+	LocalIP    types.String `tfsdk:"local_ip"`
+	LocalPort  types.String `tfsdk:"local_port"`
+	Datacenter types.String `tfsdk:"datacenter"`
+	Status     types.String `tfsdk:"status"`
+	StatusTime types.String `tfsdk:"status_time"`
+	IKEState   types.String `tfsdk:"ike_state"`
+	IPSecState types.String `tfsdk:"ipsec_state"`
+}
+
+func (d *NetworkTunnelGroupStateDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_network_tunnel_group_state"
+}
+
+func (d *NetworkTunnelGroupStateDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Fetches the hub and tunnel state of Network Tunnel Groups, for use in `check` blocks and postconditions.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				Optional:    true,
+				Description: "The ID of a Network Tunnel Group. If set, only the state of that group is fetched and `peers` lists all of its tunnels; otherwise the state of every group is fetched.",
+			},
+			"network_tunnel_groups": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "The state of the Network Tunnel Groups.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.Int64Attribute{
+							Computed:    true,
+							Description: "The ID of the network tunnel group.",
+						},
+						"name": schema.StringAttribute{
+							Computed:    true,
+							Description: "The name of the network tunnel group.",
+						},
+						"status": schema.StringAttribute{
+							Computed:    true,
+							Description: "The status of the network tunnel group (`connected`, `disconnected` or `warning`).",
+						},
+						"hubs": schema.ListNestedAttribute{
+							Computed:    true,
+							Description: "The state of the hubs of the network tunnel group.",
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"id": schema.Int64Attribute{
+										Computed:    true,
+										Description: "The ID of the hub.",
+									},
+									"is_primary": schema.BoolAttribute{
+										Computed:    true,
+										Description: "Whether the hub is in the primary data center.",
+									},
+									"datacenter": schema.StringAttribute{
+										Computed:    true,
+										Description: "The name of the data center of the hub.",
+									},
+									"status": schema.StringAttribute{
+										Computed:    true,
+										Description: "The status of the hub (`UP` or `DOWN`).",
+									},
+									"status_time": schema.StringAttribute{
+										Computed:    true,
+										Description: "The time the status of the hub was recorded.",
+									},
+									"tunnels": schema.ListNestedAttribute{
+										Computed:    true,
+										Description: "The state of the tunnels established to the hub (at most 10).",
+										NestedObject: schema.NestedAttributeObject{
+											Attributes: networkTunnelStateDataSourceAttributes(),
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"peers": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "The state of every tunnel of the Network Tunnel Group. Only set when `id` is set.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: networkTunnelStateDataSourceAttributes(),
+				},
+			},
+		},
+	}
+}
+
+// networkTunnelStateDataSourceAttributes returns the computed attributes of a tunnel state.
+func networkTunnelStateDataSourceAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"peer_id": schema.StringAttribute{
+			Computed:    true,
+			Description: "The IKE ID of the peer.",
+		},
+		"peer_ip": schema.StringAttribute{
+			Computed:    true,
+			Description: "The IP address of the peer.",
+		},
+		"peer_port": schema.StringAttribute{
+			Computed:    true,
+			Description: "The port of the peer.",
+		},
+		"local_ip": schema.StringAttribute{
+			Computed:    true,
+			Description: "The IP address of the hub end of the tunnel.",
+		},
+		"local_port": schema.StringAttribute{
+			Computed:    true,
+			Description: "The port of the hub end of the tunnel.",
+		},
+		"datacenter": schema.StringAttribute{
+			Computed:    true,
+			Description: "The data center the tunnel terminates in.",
+		},
+		"status": schema.StringAttribute{
+			Computed:    true,
+			Description: "The status of the tunnel (`UP` or `DOWN`).",
+		},
+		"status_time": schema.StringAttribute{
+			Computed:    true,
+			Description: "The time the status of the tunnel was recorded.",
+		},
+		"ike_state": schema.StringAttribute{
+			Computed:    true,
+			Description: "The state of the IKE security association (e.g., `ESTABLISHED`).",
+		},
+		"ipsec_state": schema.StringAttribute{
+			Computed:    true,
+			Description: "The state of the IPsec security association (e.g., `INSTALLED`).",
+		},
+	}
+}
+
+func (d *NetworkTunnelGroupStateDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*apiclient.APIClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *apiclient.APIClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *NetworkTunnelGroupStateDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state NetworkTunnelGroupStateDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var groups []apiclient.NetworkTunnelGroupState
+	if state.ID.IsNull() {
+		var err error
+		groups, err = apiclient.GetNetworkTunnelGroupsState(ctx, d.client)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Unable to Read Network Tunnel Groups State",
+				err.Error(),
+			)
+			return
+		}
+	} else {
+		id := state.ID.ValueInt64()
+
+		group, err := apiclient.GetNetworkTunnelGroupState(ctx, d.client, id)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Unable to Read Network Tunnel Group State",
+				err.Error(),
+			)
+			return
+		}
+		groups = append(groups, *group)
+
+		peers, err := apiclient.GetNetworkTunnelGroupPeers(ctx, d.client, id)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Unable to Read Network Tunnel Group Peers",
+				err.Error(),
+			)
+			return
+		}
+		state.Peers = mapNetworkTunnelStates(peers)
+	}
+
+	for _, group := range groups {
+		groupModel := NetworkTunnelGroupStateModel{
+			ID:     types.Int64Value(group.ID),
+			Name:   types.StringValue(group.Name),
+			Status: types.StringValue(group.Status),
+			Hubs:   []NetworkTunnelHubStateModel{},
+		}
+		for _, hub := range group.Hubs {
+			groupModel.Hubs = append(groupModel.Hubs, NetworkTunnelHubStateModel{
+				ID:         types.Int64Value(hub.ID),
+				IsPrimary:  types.BoolValue(hub.IsPrimary),
+				Datacenter: types.StringValue(hub.Datacenter.Name),
+				Status:     types.StringValue(hub.Status.Status),
+				StatusTime: types.StringValue(hub.Status.Time),
+				Tunnels:    mapNetworkTunnelStates(hub.TunnelsStatus),
+			})
+		}
+		state.NetworkTunnelGroups = append(state.NetworkTunnelGroups, groupModel)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func mapNetworkTunnelStates(tunnels []apiclient.NetworkTunnelState) []NetworkTunnelStateModel {
+	models := []NetworkTunnelStateModel{}
+	for _, tunnel := range tunnels {
+		models = append(models, NetworkTunnelStateModel{
+			PeerID:     types.StringValue(tunnel.PeerID),
+			PeerIP:     types.StringValue(tunnel.PeerIP),
+			PeerPort:   types.StringValue(tunnel.PeerPort),
+			LocalIP:    types.StringValue(tunnel.LocalIP),
+			LocalPort:  types.StringValue(tunnel.LocalPort),
+			Datacenter: types.StringValue(tunnel.DCName),
+			Status:     types.StringValue(tunnel.Status),
+			StatusTime: types.StringValue(tunnel.Time),
+			IKEState:   types.StringValue(tunnel.IKEState),
+			IPSecState: types.StringValue(tunnel.IPSecState),
+		})
+	}
+	return models
+}
@@ -0,0 +1,44 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccNetworkTunnelGroupStateDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// State of all groups
+			{
+				Config: `
+data "sse_network_tunnel_group_state" "all" {}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.sse_network_tunnel_group_state.all", "network_tunnel_groups.#"),
+					resource.TestCheckNoResourceAttr("data.sse_network_tunnel_group_state.all", "peers"),
+				),
+			},
+			// State and peers of a single group
+			{
+				Config: testAccNetworkTunnelGroupResourceConfig("tf-acc-test-tunnel-group-state", "10.40.0.0/16") + `
+data "sse_network_tunnel_group_state" "test" {
+  id = sse_network_tunnel_group.test.id
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.sse_network_tunnel_group_state.test", "network_tunnel_groups.#", "1"),
+					resource.TestCheckResourceAttrPair("data.sse_network_tunnel_group_state.test", "network_tunnel_groups.0.id", "sse_network_tunnel_group.test", "id"),
+					resource.TestCheckResourceAttr("data.sse_network_tunnel_group_state.test", "network_tunnel_groups.0.status", "disconnected"),
+					resource.TestCheckResourceAttr("data.sse_network_tunnel_group_state.test", "network_tunnel_groups.0.hubs.#", "2"),
+					resource.TestCheckResourceAttr("data.sse_network_tunnel_group_state.test", "peers.#", "0"),
+				),
+			},
+		},
+	})
+}
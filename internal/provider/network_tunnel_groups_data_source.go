@@ -107,7 +107,7 @@ func (d *NetworkTunnelGroupsDataSource) Read(ctx context.Context, req datasource
 		return
 	}
 
-	groups, err := apiclient.GetNetworkTunnelGroups(d.client)
+	groups, err := apiclient.GetNetworkTunnelGroups(ctx, d.client)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Unable to Read Network Tunnel Groups",
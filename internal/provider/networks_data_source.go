@@ -0,0 +1,134 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cisco/terraform-provider-sse/internal/apiclient"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &NetworksDataSource{}
+
+func NewNetworksDataSource() datasource.DataSource {
+	return &NetworksDataSource{}
+}
+
+type NetworksDataSource struct {
+	client *apiclient.APIClient
+}
+
+type NetworksDataSourceModel struct {
+	Networks []NetworkModel `tfsdk:"networks"`
+}
+
+type NetworkModel struct {
+	ID           types.Int64  `tfsdk:"id"`
+	Name         types.String `tfsdk:"name"`
+	IPAddress    types.String `tfsdk:"ip_address"`
+	PrefixLength types.Int64  `tfsdk:"prefix_length"`
+	IsDynamic    types.Bool   `tfsdk:"is_dynamic"`
+	Status       types.String `tfsdk:"status"`
+	IsVerified   types.Bool   `tfsdk:"is_verified"`
+}
+
+func (d *NetworksDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_networks"
+}
+
+func (d *NetworksDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Fetches the list of Networks.",
+		Attributes: map[string]schema.Attribute{
+			"networks": schema.ListNestedAttribute{
+				Computed: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: networkDataSourceAttributes(),
+				},
+			},
+		},
+	}
+}
+
+// networkDataSourceAttributes returns the computed attributes of a network.
+func networkDataSourceAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.Int64Attribute{
+			Computed:    true,
+			Description: "The origin ID of the network, usable as an identity ID in access rules.",
+		},
+		"name": schema.StringAttribute{
+			Computed:    true,
+			Description: "The name of the network.",
+		},
+		"ip_address": schema.StringAttribute{
+			Computed:    true,
+			Description: "The public IP address of the network.",
+		},
+		"prefix_length": schema.Int64Attribute{
+			Computed:    true,
+			Description: "The prefix length of the network.",
+		},
+		"is_dynamic": schema.BoolAttribute{
+			Computed:    true,
+			Description: "Whether the network has a dynamic IP address.",
+		},
+		"status": schema.StringAttribute{
+			Computed:    true,
+			Description: "The status of the network (`OPEN` or `CLOSED`).",
+		},
+		"is_verified": schema.BoolAttribute{
+			Computed:    true,
+			Description: "Whether the network is verified.",
+		},
+	}
+}
+
+func (d *NetworksDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*apiclient.APIClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *apiclient.APIClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *NetworksDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state NetworksDataSourceModel
+
+	networks, err := apiclient.GetNetworks(ctx, d.client)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Networks",
+			err.Error(),
+		)
+		return
+	}
+
+	for _, network := range networks {
+		state.Networks = append(state.Networks, NetworkModel{
+			ID:           types.Int64Value(network.OriginID),
+			Name:         types.StringValue(network.Name),
+			IPAddress:    types.StringValue(network.IPAddress),
+			PrefixLength: types.Int64Value(int64(network.PrefixLength)),
+			IsDynamic:    types.BoolValue(network.IsDynamic),
+			Status:       types.StringValue(network.Status),
+			IsVerified:   types.BoolValue(network.IsVerified),
+		})
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
@@ -0,0 +1,67 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/cisco/terraform-provider-sse/internal/apiclient"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// objectValidationDiagnostics turns the result of the network or service
+// object validate endpoints into diagnostics. attributePath returns the
+// attribute that a field error refers to. The API numbers the rejected rows
+// in an unspecified way, so when several objects are validated together
+// describeRow names the row in the message instead of it being mapped to an
+// object. If the objects could not be validated at all, only a warning is
+// added and the apply reports any problem.
+//
+// The validate endpoints check objects as if they were created. existingNames
+// is set when the objects keep the names they already have, so that name
+// errors, such as a duplicate of the object itself, are dropped: the API
+// accepted these names when the objects were created.
+func objectValidationDiagnostics(err error, kind string, existingNames bool, attributePath func(field string) path.Path, describeRow func(row int) string) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if err == nil {
+		return diags
+	}
+
+	var validationErr *apiclient.ObjectValidationError
+	if !errors.As(err, &validationErr) {
+		diags.AddWarning(
+			"Unable to Validate "+kind,
+			fmt.Sprintf("The %s could not be validated by the API: %s", kind, err),
+		)
+		return diags
+	}
+
+	for _, row := range validationErr.Rows {
+		for _, fieldErr := range row.Errors {
+			if existingNames && fieldErr.Field == "name" {
+				continue
+			}
+			detail := fmt.Sprintf("%s: %s", fieldErr.Field, fieldErr.Reason)
+			if describeRow != nil {
+				detail = describeRow(row.Row) + ": " + detail
+			}
+			diags.AddAttributeError(attributePath(fieldErr.Field), "Invalid "+kind, detail)
+		}
+	}
+	return diags
+}
+
+// knownStrings reports whether all values are known, so that they can be
+// sent to a validate endpoint.
+func knownStrings(values ...types.String) bool {
+	for _, v := range values {
+		if v.IsUnknown() {
+			return false
+		}
+	}
+	return true
+}
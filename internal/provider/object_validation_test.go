@@ -0,0 +1,61 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/cisco/terraform-provider-sse/internal/apiclient"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+func TestObjectValidationDiagnosticsExistingNames(t *testing.T) {
+	err := &apiclient.ObjectValidationError{
+		Message: "Validation failed.",
+		Rows: []apiclient.ObjectRowErrors{{
+			Row: 1,
+			Errors: []apiclient.ObjectFieldError{
+				{Field: "name", Reason: "name already exists"},
+				{Field: "value.addresses", Reason: "invalid IP address"},
+			},
+		}},
+	}
+	attributePath := func(field string) path.Path {
+		return networkObjectFieldPath(path.Empty(), field)
+	}
+
+	if got := objectValidationDiagnostics(err, "Network Object", false, attributePath, nil).ErrorsCount(); got != 2 {
+		t.Errorf("new objects: got %d errors, want 2", got)
+	}
+
+	diags := objectValidationDiagnostics(err, "Network Object", true, attributePath, nil)
+	if got := diags.ErrorsCount(); got != 1 {
+		t.Fatalf("existing names: got %d errors, want 1: %v", got, diags)
+	}
+	if got := diags.Errors()[0].(interface{ Path() path.Path }).Path(); !got.Equal(path.Root("addresses")) {
+		t.Errorf("existing names: error path = %s, want addresses", got)
+	}
+}
+
+func TestObjectValidationDiagnosticsDescribeRow(t *testing.T) {
+	err := &apiclient.ObjectValidationError{
+		Rows: []apiclient.ObjectRowErrors{{
+			Row:    2,
+			Errors: []apiclient.ObjectFieldError{{Field: "value.addresses", Reason: "invalid IP address"}},
+		}},
+	}
+
+	diags := objectValidationDiagnostics(err, "Network Object", false, func(string) path.Path {
+		return path.Root("objects")
+	}, func(row int) string {
+		return fmt.Sprintf("Row %d of the objects a, b", row)
+	})
+	if got := diags.ErrorsCount(); got != 1 {
+		t.Fatalf("got %d errors, want 1", got)
+	}
+	if got, want := diags.Errors()[0].Detail(), "Row 2 of the objects a, b: value.addresses: invalid IP address"; got != want {
+		t.Errorf("detail = %q, want %q", got, want)
+	}
+}
@@ -99,7 +99,7 @@ func (d *PrivateResourceDataSource) Read(ctx context.Context, req datasource.Rea
 	targetName := state.Name.ValueString()
 
 	// 1. Find ID by Name
-	id, err := apiclient.GetPrivateResourceIDByName(d.client, targetName)
+	id, err := apiclient.GetPrivateResourceIDByName(ctx, d.client, targetName)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Unable to Find Private Resource",
@@ -110,7 +110,7 @@ func (d *PrivateResourceDataSource) Read(ctx context.Context, req datasource.Rea
 
 	// 2. Get Full Details
 	endpoint := fmt.Sprintf("privateResources/%d", id)
-	respHTTP, err := d.client.Query("policies", endpoint, "GET", nil)
+	respHTTP, err := d.client.Query(ctx, "policies", endpoint, "GET", nil)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Unable to Read Private Resource",
@@ -121,10 +121,9 @@ func (d *PrivateResourceDataSource) Read(ctx context.Context, req datasource.Rea
 	defer respHTTP.Body.Close()
 
 	if respHTTP.StatusCode != 200 {
-		body, _ := io.ReadAll(respHTTP.Body)
 		resp.Diagnostics.AddError(
 			"Unable to Read Private Resource",
-			fmt.Sprintf("Status: %s, Body: %s", respHTTP.Status, string(body)),
+			apiclient.NewAPIError(respHTTP, "get private resource").Error(),
 		)
 		return
 	}
@@ -121,7 +121,7 @@ func (r *PrivateResourceGroupResource) Create(ctx context.Context, req resource.
 		"resourceIds": resourceIDs,
 	}
 
-	respHTTP, err := r.client.Query("policies", "privateResourceGroups", "POST", reqBody)
+	respHTTP, err := r.client.Query(ctx, "policies", "privateResourceGroups", "POST", reqBody)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error creating private resource group",
@@ -131,16 +131,16 @@ func (r *PrivateResourceGroupResource) Create(ctx context.Context, req resource.
 	}
 	defer respHTTP.Body.Close()
 
-	body, _ := io.ReadAll(respHTTP.Body)
-
 	if respHTTP.StatusCode != 200 && respHTTP.StatusCode != 201 {
 		resp.Diagnostics.AddError(
 			"Error creating private resource group",
-			fmt.Sprintf("Could not create private resource group. Status: %s, Body: %s", respHTTP.Status, string(body)),
+			apiclient.NewAPIError(respHTTP, "create private resource group").Error(),
 		)
 		return
 	}
 
+	body, _ := io.ReadAll(respHTTP.Body)
+
 	var result map[string]interface{}
 	if err := json.Unmarshal(body, &result); err != nil {
 		resp.Diagnostics.AddError(
@@ -187,7 +187,7 @@ func (r *PrivateResourceGroupResource) Read(ctx context.Context, req resource.Re
 		return
 	}
 
-	respHTTP, err := r.client.Query("policies", "privateResourceGroups/"+data.ID.ValueString(), "GET", nil)
+	respHTTP, err := r.client.Query(ctx, "policies", "privateResourceGroups/"+data.ID.ValueString(), "GET", nil)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error reading private resource group",
@@ -198,15 +198,14 @@ func (r *PrivateResourceGroupResource) Read(ctx context.Context, req resource.Re
 	defer respHTTP.Body.Close()
 
 	if respHTTP.StatusCode == 404 {
-		resp.State.RemoveResource(ctx)
+		removeResourceFromState(ctx, resp, "Private resource group", data.ID.ValueString())
 		return
 	}
 
 	if respHTTP.StatusCode != 200 {
-		body, _ := io.ReadAll(respHTTP.Body)
 		resp.Diagnostics.AddError(
 			"Error reading private resource group",
-			fmt.Sprintf("Status: %s, Body: %s", respHTTP.Status, string(body)),
+			apiclient.NewAPIError(respHTTP, "get private resource group").Error(),
 		)
 		return
 	}
@@ -263,7 +262,7 @@ func (r *PrivateResourceGroupResource) Update(ctx context.Context, req resource.
 		"resourceIds": resourceIDs,
 	}
 
-	respHTTP, err := r.client.Query("policies", "privateResourceGroups/"+data.ID.ValueString(), "PUT", reqBody)
+	respHTTP, err := r.client.Query(ctx, "policies", "privateResourceGroups/"+data.ID.ValueString(), "PUT", reqBody)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error updating private resource group",
@@ -274,10 +273,9 @@ func (r *PrivateResourceGroupResource) Update(ctx context.Context, req resource.
 	defer respHTTP.Body.Close()
 
 	if respHTTP.StatusCode != 200 {
-		body, _ := io.ReadAll(respHTTP.Body)
 		resp.Diagnostics.AddError(
 			"Error updating private resource group",
-			fmt.Sprintf("Status: %s, Body: %s", respHTTP.Status, string(body)),
+			apiclient.NewAPIError(respHTTP, "update private resource group").Error(),
 		)
 		return
 	}
@@ -315,7 +313,7 @@ func (r *PrivateResourceGroupResource) Delete(ctx context.Context, req resource.
 		return
 	}
 
-	respHTTP, err := r.client.Query("policies", "privateResourceGroups/"+data.ID.ValueString(), "DELETE", nil)
+	respHTTP, err := r.client.Query(ctx, "policies", "privateResourceGroups/"+data.ID.ValueString(), "DELETE", nil)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error deleting private resource group",
@@ -326,10 +324,9 @@ func (r *PrivateResourceGroupResource) Delete(ctx context.Context, req resource.
 	defer respHTTP.Body.Close()
 
 	if respHTTP.StatusCode != 200 && respHTTP.StatusCode != 204 {
-		body, _ := io.ReadAll(respHTTP.Body)
 		resp.Diagnostics.AddError(
 			"Error deleting private resource group",
-			fmt.Sprintf("Status: %s, Body: %s", respHTTP.Status, string(body)),
+			apiclient.NewAPIError(respHTTP, "delete private resource group").Error(),
 		)
 		return
 	}
@@ -339,7 +336,7 @@ func (r *PrivateResourceGroupResource) ImportState(ctx context.Context, req reso
 	id := req.ID
 	if _, err := strconv.ParseInt(id, 10, 64); err != nil {
 		// Not a number, try to find by name
-		foundID, err := apiclient.GetPrivateResourceGroupIDByName(r.client, id)
+		foundID, err := apiclient.GetPrivateResourceGroupIDByName(ctx, r.client, id)
 		if err != nil {
 			resp.Diagnostics.AddError(
 				"Error importing private resource group",
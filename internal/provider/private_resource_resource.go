@@ -340,7 +340,7 @@ func (r *PrivateResourceResource) Create(ctx context.Context, req resource.Creat
 	}
 	reqBody["resourceAddresses"] = resourceAddressesReq
 
-	respHTTP, err := r.client.Query("policies", "privateResources", "POST", reqBody)
+	respHTTP, err := r.client.Query(ctx, "policies", "privateResources", "POST", reqBody)
 	if err != nil {
 		resp.Diagnostics.AddError("Error creating private resource", err.Error())
 		return
@@ -348,8 +348,7 @@ func (r *PrivateResourceResource) Create(ctx context.Context, req resource.Creat
 	defer respHTTP.Body.Close()
 
 	if respHTTP.StatusCode != 200 && respHTTP.StatusCode != 201 {
-		body, _ := io.ReadAll(respHTTP.Body)
-		resp.Diagnostics.AddError("Error creating private resource", fmt.Sprintf("Status: %s, Body: %s", respHTTP.Status, string(body)))
+		resp.Diagnostics.AddError("Error creating private resource", apiclient.NewAPIError(respHTTP, "create private resource").Error())
 		return
 	}
 
@@ -391,7 +390,7 @@ func (r *PrivateResourceResource) Read(ctx context.Context, req resource.ReadReq
 	}
 
 	if !found {
-		resp.State.RemoveResource(ctx)
+		removeResourceFromState(ctx, resp, "Private resource", data.ID.ValueString())
 		return
 	}
 
@@ -513,7 +512,7 @@ func (r *PrivateResourceResource) Update(ctx context.Context, req resource.Updat
 	}
 	reqBody["resourceAddresses"] = resourceAddressesReq
 
-	respHTTP, err := r.client.Query("policies", "privateResources/"+data.ID.ValueString(), "PUT", reqBody)
+	respHTTP, err := r.client.Query(ctx, "policies", "privateResources/"+data.ID.ValueString(), "PUT", reqBody)
 	if err != nil {
 		resp.Diagnostics.AddError("Error updating private resource", err.Error())
 		return
@@ -521,8 +520,7 @@ func (r *PrivateResourceResource) Update(ctx context.Context, req resource.Updat
 	defer respHTTP.Body.Close()
 
 	if respHTTP.StatusCode != 200 {
-		body, _ := io.ReadAll(respHTTP.Body)
-		resp.Diagnostics.AddError("Error updating private resource", fmt.Sprintf("Status: %s, Body: %s", respHTTP.Status, string(body)))
+		resp.Diagnostics.AddError("Error updating private resource", apiclient.NewAPIError(respHTTP, "update private resource").Error())
 		return
 	}
 
@@ -545,7 +543,7 @@ func (r *PrivateResourceResource) Delete(ctx context.Context, req resource.Delet
 		return
 	}
 
-	respHTTP, err := r.client.Query("policies", "privateResources/"+data.ID.ValueString(), "DELETE", nil)
+	respHTTP, err := r.client.Query(ctx, "policies", "privateResources/"+data.ID.ValueString(), "DELETE", nil)
 	if err != nil {
 		resp.Diagnostics.AddError("Error deleting private resource", err.Error())
 		return
@@ -553,8 +551,7 @@ func (r *PrivateResourceResource) Delete(ctx context.Context, req resource.Delet
 	defer respHTTP.Body.Close()
 
 	if respHTTP.StatusCode != 200 && respHTTP.StatusCode != 204 {
-		body, _ := io.ReadAll(respHTTP.Body)
-		resp.Diagnostics.AddError("Error deleting private resource", fmt.Sprintf("Status: %s, Body: %s", respHTTP.Status, string(body)))
+		resp.Diagnostics.AddError("Error deleting private resource", apiclient.NewAPIError(respHTTP, "delete private resource").Error())
 		return
 	}
 }
@@ -563,7 +560,7 @@ func (r *PrivateResourceResource) ImportState(ctx context.Context, req resource.
 	id := req.ID
 	if _, err := strconv.ParseInt(id, 10, 64); err != nil {
 		// Not a number, try to find by name
-		foundID, err := apiclient.GetPrivateResourceIDByName(r.client, id)
+		foundID, err := apiclient.GetPrivateResourceIDByName(ctx, r.client, id)
 		if err != nil {
 			resp.Diagnostics.AddError(
 				"Error importing private resource",
@@ -579,7 +576,7 @@ func (r *PrivateResourceResource) ImportState(ctx context.Context, req resource.
 func (r *PrivateResourceResource) refreshResource(ctx context.Context, id string, data *PrivateResourceResourceModel) (bool, diag.Diagnostics) {
 	var diags diag.Diagnostics
 
-	respHTTP, err := r.client.Query("policies", "privateResources/"+id, "GET", nil)
+	respHTTP, err := r.client.Query(ctx, "policies", "privateResources/"+id, "GET", nil)
 	if err != nil {
 		diags.AddError("Error reading private resource", err.Error())
 		return false, diags
@@ -591,8 +588,7 @@ func (r *PrivateResourceResource) refreshResource(ctx context.Context, id string
 	}
 
 	if respHTTP.StatusCode != 200 {
-		body, _ := io.ReadAll(respHTTP.Body)
-		diags.AddError("Error reading private resource", fmt.Sprintf("Status: %s, Body: %s", respHTTP.Status, string(body)))
+		diags.AddError("Error reading private resource", apiclient.NewAPIError(respHTTP, "get private resource").Error())
 		return false, diags
 	}
 
@@ -5,9 +5,7 @@ package provider
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
-	"io"
 
 	"github.com/cisco/terraform-provider-sse/internal/apiclient"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
@@ -90,60 +88,26 @@ func (d *PrivateResourcesDataSource) Read(ctx context.Context, req datasource.Re
 	var data PrivateResourcesDataSourceModel
 
 	// Read API call logic
-	offset := 0
-	limit := 100
-	var allResources []PrivateResourceModel
+	resources, err := apiclient.CollectAll(apiclient.Paginate[apiclient.PrivateResource](ctx, d.client, apiclient.ListRequest{
+		Scope:    apiclient.ScopePolicies,
+		Endpoint: apiclient.PrivateResourcesEndpoint,
+		Style:    apiclient.PageOffset,
+		Action:   "get private resources",
+	}))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Private Resources",
+			err.Error(),
+		)
+		return
+	}
 
-	for {
-		endpoint := fmt.Sprintf("privateResources?limit=%d&offset=%d", limit, offset)
-		respHTTP, err := d.client.Query("policies", endpoint, "GET", nil)
-		if err != nil {
-			resp.Diagnostics.AddError(
-				"Unable to Read Private Resources",
-				err.Error(),
-			)
-			return
-		}
-
-		if respHTTP.StatusCode != 200 {
-			body, _ := io.ReadAll(respHTTP.Body)
-			resp.Diagnostics.AddError(
-				"Unable to Read Private Resources",
-				fmt.Sprintf("Status: %s, Body: %s", respHTTP.Status, string(body)),
-			)
-			respHTTP.Body.Close()
-			return
-		}
-
-		var result struct {
-			Items []struct {
-				ID   int    `json:"resourceId"`
-				Name string `json:"name"`
-			} `json:"items"`
-			Total int `json:"total"`
-		}
-
-		if err := json.NewDecoder(respHTTP.Body).Decode(&result); err != nil {
-			resp.Diagnostics.AddError(
-				"Unable to Decode Private Resources Response",
-				err.Error(),
-			)
-			respHTTP.Body.Close()
-			return
-		}
-		respHTTP.Body.Close()
-
-		for _, item := range result.Items {
-			allResources = append(allResources, PrivateResourceModel{
-				ID:   types.Int64Value(int64(item.ID)),
-				Name: types.StringValue(item.Name),
-			})
-		}
-
-		if len(result.Items) == 0 || offset+len(result.Items) >= result.Total {
-			break
-		}
-		offset += len(result.Items)
+	var allResources []PrivateResourceModel
+	for _, item := range resources {
+		allResources = append(allResources, PrivateResourceModel{
+			ID:   types.Int64Value(int64(item.ID)),
+			Name: types.StringValue(item.Name),
+		})
 	}
 
 	data.PrivateResources = allResources
@@ -5,13 +5,20 @@ package provider
 
 import (
 	"context"
+	"fmt"
+	"net/url"
 	"os"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
 
 	"github.com/cisco/terraform-provider-sse/internal/apiclient"
 	"github.com/hashicorp/terraform-plugin-framework/action"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
 	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -34,9 +41,63 @@ type ScaffoldingProvider struct {
 
 // ScaffoldingProviderModel describes the provider data model.
 type ScaffoldingProviderModel struct {
-	Endpoint types.String `tfsdk:"endpoint"`
+	ClientID                types.String  `tfsdk:"client_id"`
+	ClientSecret            types.String  `tfsdk:"client_secret"`
+	Region                  types.String  `tfsdk:"region"`
+	TokenURL                types.String  `tfsdk:"token_url"`
+	APIBaseURL              types.String  `tfsdk:"api_base_url"`
+	APIEndpoints            types.Map     `tfsdk:"api_endpoints"`
+	Scopes                  types.List    `tfsdk:"scopes"`
+	MaxRetries              types.Int64   `tfsdk:"max_retries"`
+	MaxRetryTime            types.String  `tfsdk:"max_retry_duration"`
+	WriteDelay              types.String  `tfsdk:"write_delay"`
+	RequestsPerSecond       types.Float64 `tfsdk:"requests_per_second"`
+	RuleMutationConcurrency types.Int64   `tfsdk:"rule_mutation_concurrency"`
 }
 
+// defaultScopes are requested when neither the provider block nor SSE_SCOPES
+// lists the OAuth scopes to request.
+var defaultScopes = []string{
+	"policies.destinationlists:read", "policies.destinationlists:write",
+	"policies.destinations:read", "policies.destinations:write",
+	"policies.objects.networkObjects:read", "policies.objects.networkObjects:write",
+	"policies.securityProfiles:read",
+	"policies.objects.serviceObjects:read", "policies.objects.serviceObjects:write",
+	"policies.objects.networkObjectGroups:read", "policies.objects.networkObjectGroups:write",
+	"policies.objects.serviceObjectGroups:read", "policies.objects.serviceObjectGroups:write",
+	"policies.rules:read", "policies.rules:write",
+	"policies.privateresources:read", "policies.privateresources:write",
+	"policies.privateresourcegroups:read", "policies.privateresourcegroups:write",
+	"deployments.privateresources:read", "deployments.privateresources:write",
+	"deployments.identities:read",
+	"deployments.networktunnelgroups:read", "deployments.networktunnelgroups:write",
+	"deployments.regions:read",
+	"deployments.internalnetworks:read", "deployments.internalnetworks:write",
+	"deployments.internaldomains:read", "deployments.internaldomains:write",
+	"deployments.sites:read", "deployments.sites:write",
+	"deployments.networks:read", "deployments.networks:write",
+	"reports.utilities:read",
+	"admin.users:read",
+	"deployments.roamingcomputers:read",
+	"deployments.resourceconnectors:read", "deployments.resourceconnectors:write",
+	"policies.contentCategories:read",
+	"policies.applicationCategories:read",
+	"policies.applicationlists:read", "policies.applicationlists:write",
+	"reports.appDiscovery:read",
+	"policies.ipsconfig:read", "policies.ipsconfig:write",
+	"policies.tenantControlsProfiles:read",
+}
+
+// apiScopes are the API scopes whose base URL can be overridden in api_endpoints.
+var apiScopes = []string{
+	apiclient.ScopePolicies,
+	apiclient.ScopeDeployments,
+	apiclient.ScopeReports,
+	apiclient.ScopeAdmin,
+}
+
+var regionPattern = regexp.MustCompile(`^[a-z][a-z0-9-]*$`)
+
 func (p *ScaffoldingProvider) Metadata(ctx context.Context, req provider.MetadataRequest, resp *provider.MetadataResponse) {
 	resp.TypeName = "sse"
 	resp.Version = p.version
@@ -44,9 +105,57 @@ func (p *ScaffoldingProvider) Metadata(ctx context.Context, req provider.Metadat
 
 func (p *ScaffoldingProvider) Schema(ctx context.Context, req provider.SchemaRequest, resp *provider.SchemaResponse) {
 	resp.Schema = schema.Schema{
+		MarkdownDescription: "The `sse` provider manages Cisco Secure Access resources. Connection and credential attributes can also be set through environment variables; values in the provider block take precedence.",
 		Attributes: map[string]schema.Attribute{
-			"endpoint": schema.StringAttribute{
-				MarkdownDescription: "Example provider attribute",
+			"client_id": schema.StringAttribute{
+				MarkdownDescription: "API key (client ID) used to obtain OAuth tokens. Can also be set with the `SSE_CLIENT_KEY` or `SSE_CLIENT_ID` environment variable.",
+				Optional:            true,
+			},
+			"client_secret": schema.StringAttribute{
+				MarkdownDescription: "API key secret used to obtain OAuth tokens. Can also be set with the `SSE_CLIENT_SECRET` environment variable.",
+				Optional:            true,
+				Sensitive:           true,
+			},
+			"region": schema.StringAttribute{
+				MarkdownDescription: "API region used for the Reporting API (e.g. `us`, `eu`). Can also be set with the `SSE_REGION` environment variable. Defaults to `us`.",
+				Optional:            true,
+			},
+			"token_url": schema.StringAttribute{
+				MarkdownDescription: "OAuth token endpoint. Can also be set with the `SSE_TOKEN_URL` environment variable. Defaults to `" + apiclient.DefaultTokenURL + "`.",
+				Optional:            true,
+			},
+			"api_base_url": schema.StringAttribute{
+				MarkdownDescription: "Base URL of the Secure Access API. A plain URL gets `/{scope}/v2` appended; a URL containing the `{scope}` and `{region}` placeholders is used as a template, e.g. `http://localhost:8080/{scope}`. `{scope}` expands to `reports.<region>` for the Reporting API. Can also be set with the `SSE_API_BASE_URL` environment variable. Defaults to `" + apiclient.DefaultAPIBaseURL + "`.",
+				Optional:            true,
+			},
+			"api_endpoints": schema.MapAttribute{
+				MarkdownDescription: "Per-scope base URL overrides keyed by API scope (`policies`, `deployments`, `reports`, `admin`). Values are full base URLs, such as `https://proxy.example.com/deployments/v2`, and may use the `{scope}` and `{region}` placeholders. Scopes without an entry use `api_base_url`.",
+				Optional:            true,
+				ElementType:         types.StringType,
+			},
+			"scopes": schema.ListAttribute{
+				MarkdownDescription: "OAuth scopes to request with the token. Can also be set with the `SSE_SCOPES` environment variable (comma or space separated). Defaults to the scopes needed by every resource and data source in this provider.",
+				Optional:            true,
+				ElementType:         types.StringType,
+			},
+			"max_retries": schema.Int64Attribute{
+				MarkdownDescription: fmt.Sprintf("Maximum number of retries for a request that failed with a transport error, a rate limit (`429`) or a locked ruleset (`409`). Waits requested by the API through `Retry-After` or `X-RateLimit-Reset` are honoured, otherwise retries back off exponentially with jitter. Defaults to `%d`.", apiclient.DefaultMaxRetries),
+				Optional:            true,
+			},
+			"max_retry_duration": schema.StringAttribute{
+				MarkdownDescription: "Maximum time spent on a single request including all retries, as a Go duration such as `5m`. `0s` removes the limit. Defaults to `" + apiclient.DefaultMaxRetryTime.String() + "`.",
+				Optional:            true,
+			},
+			"write_delay": schema.StringAttribute{
+				MarkdownDescription: "Time to wait after each access rule create, update or delete before the next rule change is sent, as a Go duration such as `2s`. Gives the API time to release the ruleset lock. Other resources are not delayed. `0s` disables the delay. Defaults to `" + apiclient.DefaultWriteDelay.String() + "`.",
+				Optional:            true,
+			},
+			"requests_per_second": schema.Float64Attribute{
+				MarkdownDescription: fmt.Sprintf("Maximum number of requests per second sent to each API scope (`policies`, `deployments`, `reports`, `admin`). Requests from all resources and data sources share this budget, so Terraform's default parallelism stays within the API rate limits. `0` disables client-side rate limiting. Defaults to `%g`.", apiclient.DefaultRequestsPerSecond),
+				Optional:            true,
+			},
+			"rule_mutation_concurrency": schema.Int64Attribute{
+				MarkdownDescription: fmt.Sprintf("Maximum number of access rule changes sent to the API at once. The API locks the ruleset while a rule is changed, so further `sse_access_rule` creates, updates and deletes in the same run wait for a free slot instead of failing with `409 Conflict`. Defaults to `%d`.", apiclient.DefaultRuleMutationConcurrency),
 				Optional:            true,
 			},
 		},
@@ -62,55 +171,162 @@ func (p *ScaffoldingProvider) Configure(ctx context.Context, req provider.Config
 		return
 	}
 
-	// Configuration values are now available.
-	// if data.Endpoint.IsNull() { /* ... */ }
-
-	// Retrieve configuration from environment variables
-	clientID := os.Getenv("SSE_CLIENT_KEY")
-	if clientID == "" {
-		// Fallback to old variable name for backward compatibility or user convenience
-		clientID = os.Getenv("SSE_CLIENT_ID")
+	// Values that are only known after apply cannot be used to build the client.
+	unknownAttributes := []struct {
+		name    string
+		unknown bool
+	}{
+		{"client_id", data.ClientID.IsUnknown()},
+		{"client_secret", data.ClientSecret.IsUnknown()},
+		{"region", data.Region.IsUnknown()},
+		{"token_url", data.TokenURL.IsUnknown()},
+		{"api_base_url", data.APIBaseURL.IsUnknown()},
+		{"api_endpoints", data.APIEndpoints.IsUnknown()},
+		{"scopes", data.Scopes.IsUnknown()},
+		{"max_retries", data.MaxRetries.IsUnknown()},
+		{"max_retry_duration", data.MaxRetryTime.IsUnknown()},
+		{"write_delay", data.WriteDelay.IsUnknown()},
+		{"requests_per_second", data.RequestsPerSecond.IsUnknown()},
+		{"rule_mutation_concurrency", data.RuleMutationConcurrency.IsUnknown()},
 	}
-	clientSecret := os.Getenv("SSE_CLIENT_SECRET")
-	tokenURL := os.Getenv("SSE_TOKEN_URL")
-	region := os.Getenv("SSE_REGION")
-	if region == "" {
-		region = "us"
+	for _, attr := range unknownAttributes {
+		if attr.unknown {
+			resp.Diagnostics.AddAttributeError(
+				path.Root(attr.name),
+				"Unknown Provider Configuration Value",
+				fmt.Sprintf("The provider cannot create the API client because %q is unknown. Set it statically or through its environment variable.", attr.name),
+			)
+		}
 	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Provider block values take precedence over environment variables
+	clientID := stringConfigValue(data.ClientID, "SSE_CLIENT_KEY", "SSE_CLIENT_ID")
+	clientSecret := stringConfigValue(data.ClientSecret, "SSE_CLIENT_SECRET")
+	region := stringConfigValue(data.Region, "SSE_REGION")
+	tokenURL := stringConfigValue(data.TokenURL, "SSE_TOKEN_URL")
+	baseURL := stringConfigValue(data.APIBaseURL, "SSE_API_BASE_URL")
 
+	if region == "" {
+		region = apiclient.DefaultRegion
+	}
 	if tokenURL == "" {
-		tokenURL = "https://api.sse.cisco.com/auth/v2/token"
+		tokenURL = apiclient.DefaultTokenURL
+	}
+	if baseURL == "" {
+		baseURL = apiclient.DefaultAPIBaseURL
 	}
 
-	if clientID == "" || clientSecret == "" {
-		resp.Diagnostics.AddError(
-			"Missing Configuration",
-			"SSE_CLIENT_KEY (or SSE_CLIENT_ID) and SSE_CLIENT_SECRET environment variables must be set.",
+	scopeURLs := map[string]string{}
+	if !data.APIEndpoints.IsNull() {
+		resp.Diagnostics.Append(data.APIEndpoints.ElementsAs(ctx, &scopeURLs, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	scopes := defaultScopes
+	if !data.Scopes.IsNull() {
+		resp.Diagnostics.Append(data.Scopes.ElementsAs(ctx, &scopes, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	} else if env := os.Getenv("SSE_SCOPES"); env != "" {
+		scopes = strings.FieldsFunc(env, func(r rune) bool {
+			return r == ',' || r == ' '
+		})
+	}
+
+	if clientID == "" {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("client_id"),
+			"Missing API Client ID",
+			"Set client_id in the provider block or the SSE_CLIENT_KEY (or SSE_CLIENT_ID) environment variable.",
 		)
-		return
+	}
+	if clientSecret == "" {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("client_secret"),
+			"Missing API Client Secret",
+			"Set client_secret in the provider block or the SSE_CLIENT_SECRET environment variable.",
+		)
+	}
+	if !regionPattern.MatchString(region) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("region"),
+			"Invalid Region",
+			fmt.Sprintf("Region %q must be a lowercase region identifier such as \"us\" or \"eu\".", region),
+		)
+	}
+	if err := validateHTTPURL(tokenURL); err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("token_url"), "Invalid Token URL", err.Error())
+	}
+	if err := validateHTTPURL(expandURLPlaceholders(baseURL)); err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("api_base_url"), "Invalid API Base URL", err.Error())
+	}
+	for scope, endpoint := range scopeURLs {
+		if !slices.Contains(apiScopes, scope) {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("api_endpoints").AtMapKey(scope),
+				"Invalid API Endpoint Scope",
+				fmt.Sprintf("%q is not an API scope. Valid scopes are: %s.", scope, strings.Join(apiScopes, ", ")),
+			)
+			continue
+		}
+		if err := validateHTTPURL(expandURLPlaceholders(endpoint)); err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("api_endpoints").AtMapKey(scope), "Invalid API Endpoint", err.Error())
+		}
+	}
+	if len(scopes) == 0 {
+		resp.Diagnostics.AddAttributeError(path.Root("scopes"), "Invalid Scopes", "At least one OAuth scope must be requested.")
+	}
+	for _, scope := range scopes {
+		if strings.TrimSpace(scope) == "" {
+			resp.Diagnostics.AddAttributeError(path.Root("scopes"), "Invalid Scopes", "OAuth scopes must not be empty strings.")
+			break
+		}
 	}
 
-	scopes := []string{
-		"policies.destinationlists:read", "policies.destinationlists:write",
-		"policies.destinations:read", "policies.destinations:write",
-		"policies.objects.networkObjects:read", "policies.objects.networkObjects:write",
-		"policies.securityProfiles:read",
-		"policies.objects.serviceObjects:read", "policies.objects.serviceObjects:write",
-		"policies.rules:read", "policies.rules:write",
-		"policies.privateresources:read", "policies.privateresources:write",
-		"policies.privateresourcegroups:read", "policies.privateresourcegroups:write",
-		"deployments.privateresources:read", "deployments.privateresources:write",
-		"deployments.identities:read",
-		"deployments.networktunnelgroups:read",
-		"reports.utilities:read",
-		"admin.users:read",
-		"deployments.roamingcomputers:read",
-		"deployments.resourceconnectors:read", "deployments.resourceconnectors:write",
-		"policies.contentCategories:read",
-		"policies.applicationCategories:read",
-		"reports.appDiscovery:read",
-		"policies.ipsconfig:read",
-		"policies.tenantControlsProfiles:read",
+	retryPolicy := apiclient.DefaultRetryPolicy()
+	if !data.MaxRetries.IsNull() {
+		if data.MaxRetries.ValueInt64() < 0 {
+			resp.Diagnostics.AddAttributeError(path.Root("max_retries"), "Invalid Max Retries", "max_retries must not be negative.")
+		}
+		retryPolicy.MaxRetries = int(data.MaxRetries.ValueInt64())
+	}
+	if !data.MaxRetryTime.IsNull() {
+		d, err := parseDuration(data.MaxRetryTime.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("max_retry_duration"), "Invalid Max Retry Duration", err.Error())
+		}
+		retryPolicy.MaxElapsed = d
+	}
+	writeDelay := apiclient.DefaultWriteDelay
+	if !data.WriteDelay.IsNull() {
+		d, err := parseDuration(data.WriteDelay.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("write_delay"), "Invalid Write Delay", err.Error())
+		}
+		writeDelay = d
+	}
+	requestsPerSecond := apiclient.DefaultRequestsPerSecond
+	if !data.RequestsPerSecond.IsNull() {
+		requestsPerSecond = data.RequestsPerSecond.ValueFloat64()
+		if requestsPerSecond < 0 {
+			resp.Diagnostics.AddAttributeError(path.Root("requests_per_second"), "Invalid Requests Per Second", "requests_per_second must not be negative.")
+		}
+	}
+	ruleMutationConcurrency := apiclient.DefaultRuleMutationConcurrency
+	if !data.RuleMutationConcurrency.IsNull() {
+		if data.RuleMutationConcurrency.ValueInt64() < 1 {
+			resp.Diagnostics.AddAttributeError(path.Root("rule_mutation_concurrency"), "Invalid Rule Mutation Concurrency", "rule_mutation_concurrency must be at least 1.")
+		}
+		ruleMutationConcurrency = int(data.RuleMutationConcurrency.ValueInt64())
+	}
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
 	// Create the API client
@@ -122,11 +338,62 @@ func (p *ScaffoldingProvider) Configure(ctx context.Context, req provider.Config
 		)
 		return
 	}
+	client.BaseURL = baseURL
+	client.ScopeURLs = scopeURLs
+	client.RetryPolicy = retryPolicy
+	client.WriteDelay = writeDelay
+	client.RequestsPerSecond = requestsPerSecond
+	client.RuleMutationConcurrency = ruleMutationConcurrency
 
 	resp.DataSourceData = client
 	resp.ResourceData = client
 }
 
+// stringConfigValue returns the configured value, falling back to the first
+// non-empty environment variable in envVars.
+func stringConfigValue(value types.String, envVars ...string) string {
+	if !value.IsNull() {
+		return value.ValueString()
+	}
+	for _, name := range envVars {
+		if v := os.Getenv(name); v != "" {
+			return v
+		}
+	}
+	return ""
+}
+
+// expandURLPlaceholders fills the URL template placeholders with sample values
+// so that templates can be validated as ordinary URLs.
+func expandURLPlaceholders(raw string) string {
+	raw = strings.ReplaceAll(raw, apiclient.ScopePlaceholder, apiclient.ScopePolicies)
+	return strings.ReplaceAll(raw, apiclient.RegionPlaceholder, apiclient.DefaultRegion)
+}
+
+// parseDuration parses a non-negative Go duration string such as "30s".
+func parseDuration(raw string) (time.Duration, error) {
+	d, err := time.ParseDuration(raw)
+	if err != nil {
+		return 0, fmt.Errorf("%q is not a valid duration, use a value such as \"30s\" or \"5m\"", raw)
+	}
+	if d < 0 {
+		return 0, fmt.Errorf("%q must not be negative", raw)
+	}
+	return d, nil
+}
+
+// validateHTTPURL checks that raw is an absolute http or https URL.
+func validateHTTPURL(raw string) error {
+	u, err := url.Parse(raw)
+	if err != nil {
+		return fmt.Errorf("%q is not a valid URL: %w", raw, err)
+	}
+	if (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return fmt.Errorf("%q must be an absolute http or https URL", raw)
+	}
+	return nil
+}
+
 func (p *ScaffoldingProvider) Resources(ctx context.Context) []func() resource.Resource {
 	return []func() resource.Resource{
 		NewNetworkObjectResource,
@@ -136,6 +403,16 @@ func (p *ScaffoldingProvider) Resources(ctx context.Context) []func() resource.R
 		NewPrivateResourceGroupResource,
 		NewPrivateResourceResource,
 		NewConnectorGroupResource,
+		NewInternalNetworkResource,
+		NewInternalDomainResource,
+		NewSiteResource,
+		NewNetworkResource,
+		NewNetworkTunnelGroupResource,
+		NewNetworkObjectGroupResource,
+		NewServiceObjectGroupResource,
+		NewNetworkObjectsBulkResource,
+		NewApplicationListResource,
+		NewIPSProfileResource,
 	}
 }
 
@@ -161,6 +438,22 @@ func (p *ScaffoldingProvider) DataSources(ctx context.Context) []func() datasour
 		NewPrivateResourceDataSource,
 		NewTenantControlsProfilesDataSource,
 		NewTenantControlsProfileDataSource,
+		NewInternalNetworksDataSource,
+		NewInternalNetworkDataSource,
+		NewInternalDomainsDataSource,
+		NewSitesDataSource,
+		NewSiteDataSource,
+		NewNetworksDataSource,
+		NewNetworkDataSource,
+		NewNetworkTunnelGroupStateDataSource,
+		NewRegionsDataSource,
+		NewNetworkObjectGroupsDataSource,
+		NewServiceObjectGroupsDataSource,
+		NewNetworkObjectDataSource,
+		NewServiceObjectDataSource,
+		NewApplicationListDataSource,
+		NewApplicationUsageDataSource,
+		NewIPSSignaturesDataSource,
 	}
 }
 
@@ -4,8 +4,10 @@
 package provider
 
 import (
+	"os"
 	"testing"
 
+	"github.com/cisco/terraform-provider-sse/internal/apiclient"
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
 	"github.com/hashicorp/terraform-plugin-testing/echoprovider"
@@ -32,3 +34,31 @@ func testAccPreCheck(t *testing.T) {
 	// about the appropriate environment variables being set are common to see in a pre-check
 	// function.
 }
+
+// testAccAPIClient returns an API client configured from the same environment
+// variables as the provider, for arranging objects outside of Terraform.
+func testAccAPIClient(t *testing.T) *apiclient.APIClient {
+	t.Helper()
+
+	clientID := os.Getenv("SSE_CLIENT_KEY")
+	if clientID == "" {
+		clientID = os.Getenv("SSE_CLIENT_ID")
+	}
+	tokenURL := os.Getenv("SSE_TOKEN_URL")
+	if tokenURL == "" {
+		tokenURL = apiclient.DefaultTokenURL
+	}
+	region := os.Getenv("SSE_REGION")
+	if region == "" {
+		region = apiclient.DefaultRegion
+	}
+
+	client := apiclient.NewAPIClient(tokenURL, clientID, os.Getenv("SSE_CLIENT_SECRET"), defaultScopes, region)
+	if client == nil {
+		t.Fatal("SSE_CLIENT_ID and SSE_CLIENT_SECRET must be set for acceptance tests")
+	}
+	if baseURL := os.Getenv("SSE_API_BASE_URL"); baseURL != "" {
+		client.BaseURL = baseURL
+	}
+	return client
+}
@@ -0,0 +1,212 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/cisco/terraform-provider-sse/internal/apiclient"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &RegionsDataSource{}
+var _ datasource.DataSourceWithValidateConfig = &RegionsDataSource{}
+
+func NewRegionsDataSource() datasource.DataSource {
+	return &RegionsDataSource{}
+}
+
+type RegionsDataSource struct {
+	client *apiclient.APIClient
+}
+
+type RegionsDataSourceModel struct {
+	Status       types.String   `tfsdk:"status"`
+	PeerIP       types.String   `tfsdk:"peer_ip"`
+	Regions      []RegionModel  `tfsdk:"regions"`
+	BGPASNumber  types.String   `tfsdk:"bgp_as_number"`
+	BGPPeerIPs   []types.String `tfsdk:"bgp_peer_ips"`
+	BGPPeerRange types.String   `tfsdk:"bgp_peer_range"`
+}
+
+type RegionModel struct {
+	Name        types.String `tfsdk:"name"`
+	DisplayName types.String `tfsdk:"display_name"`
+	Description types.String `tfsdk:"description"`
+	Continent   types.String `tfsdk:"continent"`
+}
+
+func (d *RegionsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_regions"
+}
+
+func (d *RegionsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Fetches the regions Network Tunnel Groups can be placed in. The Regions API does not return data center IP addresses: the BGP peer IPs are link-local addresses of the hubs, not egress IPs. The IP addresses of the hubs a tunnel group terminates on are exported in the `hubs` attribute of `sse_network_tunnel_group`.",
+		Attributes: map[string]schema.Attribute{
+			"status": schema.StringAttribute{
+				Optional:    true,
+				Description: "Set to `all` to fetch every region, or `available` (the default) to fetch only the regions that accept new tunnels.",
+			},
+			"peer_ip": schema.StringAttribute{
+				Optional:    true,
+				Description: "A public IP address. If set, the regions are sorted by their distance to the location of this IP address.",
+			},
+			"regions": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "The regions.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Computed:    true,
+							Description: "The identifier of the region (e.g., us-east-1), used as `region` in `sse_network_tunnel_group`.",
+						},
+						"display_name": schema.StringAttribute{
+							Computed:    true,
+							Description: "The display name of the region (e.g., US East 1).",
+						},
+						"description": schema.StringAttribute{
+							Computed:    true,
+							Description: "The country and location of the region.",
+						},
+						"continent": schema.StringAttribute{
+							Computed:    true,
+							Description: "The continent the region is located in.",
+						},
+					},
+				},
+			},
+			"bgp_as_number": schema.StringAttribute{
+				Computed:    true,
+				Description: "The BGP autonomous system number of the Secure Access hubs.",
+			},
+			"bgp_peer_ips": schema.ListAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+				Description: "The link-local BGP peer IP addresses of the Secure Access hubs. They are not data center or egress IP addresses.",
+			},
+			"bgp_peer_range": schema.StringAttribute{
+				Computed:    true,
+				Description: "The link-local range the BGP peer IP addresses are allocated from.",
+			},
+		},
+	}
+}
+
+func (d *RegionsDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	var config RegionsDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !config.Status.IsNull() && !config.Status.IsUnknown() {
+		if status := config.Status.ValueString(); status != "all" && status != "available" {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("status"),
+				"Invalid Status",
+				fmt.Sprintf("The status must be all or available, got %q.", status),
+			)
+		}
+	}
+}
+
+func (d *RegionsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*apiclient.APIClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *apiclient.APIClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *RegionsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state RegionsDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	regions, err := apiclient.GetRegions(ctx, d.client, apiclient.RegionFilters{
+		Status: state.Status.ValueString(),
+		PeerIP: state.PeerIP.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Regions",
+			err.Error(),
+		)
+		return
+	}
+
+	for _, region := range regions.Regions {
+		state.Regions = append(state.Regions, RegionModel{
+			Name:        types.StringValue(region.Region),
+			DisplayName: types.StringValue(region.Name),
+			Description: types.StringValue(region.Description),
+			Continent:   types.StringValue(region.Continent),
+		})
+	}
+
+	state.BGPASNumber = types.StringValue(regions.BGP.ASNumber)
+	state.BGPPeerIPs = []types.String{}
+	for _, ip := range regions.BGP.PeerIPs {
+		state.BGPPeerIPs = append(state.BGPPeerIPs, types.StringValue(ip))
+	}
+	state.BGPPeerRange = types.StringValue(regions.BGP.PeerRange)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// validateRegion checks at plan time that region is one of the regions returned
+// by the Regions API. Unknown values are left to the apply. If the regions
+// cannot be listed, for example because the API key lacks the
+// deployments.regions:read scope, only a warning is added.
+func validateRegion(ctx context.Context, client *apiclient.APIClient, attribute path.Path, region types.String) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if client == nil || region.IsNull() || region.IsUnknown() {
+		return diags
+	}
+
+	regions, err := apiclient.GetRegions(ctx, client, apiclient.RegionFilters{Status: "all"})
+	if err != nil {
+		diags.AddAttributeWarning(
+			attribute,
+			"Unable to Validate Region",
+			"The region could not be checked against the list of regions: "+err.Error(),
+		)
+		return diags
+	}
+
+	names := make([]string, 0, len(regions.Regions))
+	for _, r := range regions.Regions {
+		if r.Region == region.ValueString() {
+			return diags
+		}
+		names = append(names, r.Region)
+	}
+
+	diags.AddAttributeError(
+		attribute,
+		"Invalid Region",
+		fmt.Sprintf("%q is not a valid region. Valid regions are: %s.", region.ValueString(), strings.Join(names, ", ")),
+	)
+	return diags
+}
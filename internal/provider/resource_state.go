@@ -0,0 +1,35 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cisco/terraform-provider-sse/internal/apiclient"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// removeMissingResource removes the resource from state when err reports that
+// the object no longer exists in the API, and reports whether it did. Plans
+// then recreate the object instead of failing on the refresh.
+func removeMissingResource(ctx context.Context, resp *resource.ReadResponse, err error, kind, id string) bool {
+	if !apiclient.IsNotFound(err) {
+		return false
+	}
+	removeResourceFromState(ctx, resp, kind, id)
+	return true
+}
+
+// removeResourceFromState drops an object that was deleted outside of
+// Terraform from state and warns the user about it.
+func removeResourceFromState(ctx context.Context, resp *resource.ReadResponse, kind, id string) {
+	tflog.Warn(ctx, "Object not found, removing it from state", map[string]interface{}{"kind": kind, "id": id})
+	resp.Diagnostics.AddWarning(
+		kind+" Not Found",
+		fmt.Sprintf("%s %s no longer exists and has been removed from the Terraform state. It was probably deleted outside of Terraform; the next apply creates it again.", kind, id),
+	)
+	resp.State.RemoveResource(ctx)
+}
@@ -28,6 +28,14 @@ type SecurityProfileDataSourceModel struct {
 	Name      types.String `tfsdk:"name"`
 	IsDefault types.Bool   `tfsdk:"is_default"`
 	Priority  types.Int64  `tfsdk:"priority"`
+
+	IsSwgDefault                   types.Bool  `tfsdk:"is_swg_default"`
+	IsFwaasDefault                 types.Bool  `tfsdk:"is_fwaas_default"`
+	SecuritySettingGroupID         types.Int64 `tfsdk:"security_setting_group_id"`
+	PolicySettingGroupID           types.Int64 `tfsdk:"policy_setting_group_id"`
+	FileInspectionSettingGroupID   types.Int64 `tfsdk:"file_inspection_setting_group_id"`
+	BypassInspectionSettingGroupID types.Int64 `tfsdk:"bypass_inspection_setting_group_id"`
+	TenantControlsProfileID        types.Int64 `tfsdk:"tenant_controls_profile_id"`
 }
 
 func (d *SecurityProfileDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -36,7 +44,7 @@ func (d *SecurityProfileDataSource) Metadata(ctx context.Context, req datasource
 
 func (d *SecurityProfileDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		Description: "Fetches a single Security Profile by name.",
+		Description: "Fetches a single Security Profile by name, including the IDs of the setting groups it links to. The Security Profiles API is read-only, so profiles are managed in the Secure Access dashboard. The API does not return the IPS settings of a profile, so they are not exported.",
 		Attributes: map[string]schema.Attribute{
 			"id": schema.Int64Attribute{
 				Computed: true,
@@ -51,6 +59,34 @@ func (d *SecurityProfileDataSource) Schema(ctx context.Context, req datasource.S
 			"priority": schema.Int64Attribute{
 				Computed: true,
 			},
+			"is_swg_default": schema.BoolAttribute{
+				Computed:    true,
+				Description: "Whether the profile is the default profile for the Secure Web Gateway.",
+			},
+			"is_fwaas_default": schema.BoolAttribute{
+				Computed:    true,
+				Description: "Whether the profile is the default profile for the cloud-delivered firewall.",
+			},
+			"security_setting_group_id": schema.Int64Attribute{
+				Computed:    true,
+				Description: "The ID of the security setting group of the profile.",
+			},
+			"policy_setting_group_id": schema.Int64Attribute{
+				Computed:    true,
+				Description: "The ID of the policy setting group of the profile, which holds the decryption settings.",
+			},
+			"file_inspection_setting_group_id": schema.Int64Attribute{
+				Computed:    true,
+				Description: "The ID of the file inspection setting group of the profile.",
+			},
+			"bypass_inspection_setting_group_id": schema.Int64Attribute{
+				Computed:    true,
+				Description: "The ID of the setting group of the destinations that bypass inspection.",
+			},
+			"tenant_controls_profile_id": schema.Int64Attribute{
+				Computed:    true,
+				Description: "The ID of the Tenant Controls profile of the profile, or null if it has none.",
+			},
 		},
 	}
 }
@@ -82,7 +118,7 @@ func (d *SecurityProfileDataSource) Read(ctx context.Context, req datasource.Rea
 
 	targetName := state.Name.ValueString()
 
-	profiles, err := d.client.GetSecurityProfiles()
+	profiles, err := d.client.GetSecurityProfiles(ctx)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Unable to Read Security Profiles",
@@ -112,5 +148,25 @@ func (d *SecurityProfileDataSource) Read(ctx context.Context, req datasource.Rea
 	state.IsDefault = types.BoolValue(foundProfile.IsDefault)
 	state.Priority = types.Int64Value(int64(foundProfile.Priority))
 
+	details, err := apiclient.GetSecurityProfile(ctx, d.client, int64(foundProfile.ID))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Security Profile",
+			err.Error(),
+		)
+		return
+	}
+
+	state.IsSwgDefault = types.BoolValue(details.IsSwgDefault)
+	state.IsFwaasDefault = types.BoolValue(details.IsFwaasDefault)
+	state.SecuritySettingGroupID = types.Int64Value(details.SecuritySettingGroupID)
+	state.PolicySettingGroupID = types.Int64Value(details.PolicySettingGroupID)
+	state.FileInspectionSettingGroupID = types.Int64Value(details.FileInspectionSettingGroupID)
+	state.BypassInspectionSettingGroupID = types.Int64Value(details.SettingGroupBypassInspectionGroupID)
+	state.TenantControlsProfileID = types.Int64Null()
+	if details.TenantControlsRestriction != nil {
+		state.TenantControlsProfileID = types.Int64Value(details.TenantControlsRestriction.ID)
+	}
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
@@ -85,7 +85,7 @@ func (d *SecurityProfilesDataSource) Configure(ctx context.Context, req datasour
 func (d *SecurityProfilesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
 	var state SecurityProfilesDataSourceModel
 
-	profiles, err := d.client.GetSecurityProfiles()
+	profiles, err := d.client.GetSecurityProfiles(ctx)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Unable to Read Security Profiles",
@@ -0,0 +1,155 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cisco/terraform-provider-sse/internal/apiclient"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &ServiceObjectDataSource{}
+var _ datasource.DataSourceWithValidateConfig = &ServiceObjectDataSource{}
+
+func NewServiceObjectDataSource() datasource.DataSource {
+	return &ServiceObjectDataSource{}
+}
+
+type ServiceObjectDataSource struct {
+	client *apiclient.APIClient
+}
+
+type ServiceObjectDataSourceModel struct {
+	Name        types.String              `tfsdk:"name"`
+	Kind        types.String              `tfsdk:"kind"`
+	ID          types.Int64               `tfsdk:"id"`
+	Type        types.String              `tfsdk:"type"`
+	Description types.String              `tfsdk:"description"`
+	Values      []ServiceObjectValueModel `tfsdk:"values"`
+	ObjectIDs   []types.Int64             `tfsdk:"object_ids"`
+	GroupIDs    []types.Int64             `tfsdk:"group_ids"`
+}
+
+type ServiceObjectValueModel struct {
+	Protocol types.String   `tfsdk:"protocol"`
+	Ports    []types.String `tfsdk:"ports"`
+}
+
+func (d *ServiceObjectDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_service_object"
+}
+
+func (d *ServiceObjectDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	attributes := unifiedObjectDataSourceAttributes("service")
+	attributes["type"] = schema.StringAttribute{
+		Computed:    true,
+		Description: "The protocol of the service object (`tcp`, `udp`, `icmp` or `any`), or `group` for a service object group.",
+	}
+	attributes["values"] = schema.ListNestedAttribute{
+		Computed:    true,
+		Description: "The value of the service object, or the inline values of the service object group.",
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: map[string]schema.Attribute{
+				"protocol": schema.StringAttribute{
+					Computed:    true,
+					Description: "The protocol of the value (`tcp`, `udp`, `icmp` or `any`).",
+				},
+				"ports": schema.ListAttribute{
+					Computed:    true,
+					ElementType: types.StringType,
+					Description: "The ports or port ranges of the value.",
+				},
+			},
+		},
+	}
+
+	resp.Schema = schema.Schema{
+		Description: "Fetches a Service Object or Service Object Group by name, including the system-defined ones and those not managed by Terraform.",
+		Attributes:  attributes,
+	}
+}
+
+func (d *ServiceObjectDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	var kind types.String
+
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("kind"), &kind)...)
+	resp.Diagnostics.Append(validateUnifiedObjectKind(kind)...)
+}
+
+func (d *ServiceObjectDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*apiclient.APIClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *apiclient.APIClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *ServiceObjectDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state ServiceObjectDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	name := state.Name.ValueString()
+
+	matches, err := apiclient.GetUnifiedServiceObjectsByName(ctx, d.client, name)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Service Objects",
+			err.Error(),
+		)
+		return
+	}
+
+	object, diags := findUnifiedObject(matches, func(o apiclient.UnifiedServiceObject) string { return o.Type }, state.Kind, "service object", name)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	values := object.Values
+	objectType := object.Type
+	if object.Value != nil {
+		values = []apiclient.ServiceObjectValue{*object.Value}
+		if objectType == "" {
+			objectType = object.Value.Protocol
+		}
+	}
+
+	state.ID = types.Int64Value(object.ID)
+	state.Kind = types.StringValue(unifiedObjectKind(objectType))
+	state.Type = types.StringValue(objectType)
+	state.Description = types.StringValue(object.Description)
+	state.ObjectIDs = objectGroupMemberIDValues(object.Objects)
+	state.GroupIDs = objectGroupMemberIDValues(object.Groups)
+	state.Values = []ServiceObjectValueModel{}
+	for _, value := range values {
+		ports := []types.String{}
+		for _, port := range value.Ports {
+			ports = append(ports, types.StringValue(port))
+		}
+		state.Values = append(state.Values, ServiceObjectValueModel{
+			Protocol: types.StringValue(value.Protocol),
+			Ports:    ports,
+		})
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
@@ -0,0 +1,51 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccServiceObjectDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+resource "sse_service_object" "test" {
+  name     = "tf-acc-test-unified-svc"
+  protocol = "tcp"
+  ports    = ["8443"]
+}
+
+resource "sse_service_object_group" "test" {
+  name       = "tf-acc-test-unified-svc-group"
+  object_ids = [sse_service_object.test.object_id]
+}
+
+data "sse_service_object" "object" {
+  name = sse_service_object.test.name
+}
+
+data "sse_service_object" "group" {
+  name = sse_service_object_group.test.name
+  kind = "group"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.sse_service_object.object", "id", "sse_service_object.test", "object_id"),
+					resource.TestCheckResourceAttr("data.sse_service_object.object", "kind", "object"),
+					resource.TestCheckResourceAttr("data.sse_service_object.object", "type", "tcp"),
+					resource.TestCheckResourceAttr("data.sse_service_object.object", "values.0.ports.0", "8443"),
+					resource.TestCheckResourceAttrPair("data.sse_service_object.group", "id", "sse_service_object_group.test", "id"),
+					resource.TestCheckResourceAttr("data.sse_service_object.group", "kind", "group"),
+					resource.TestCheckResourceAttrPair("data.sse_service_object.group", "object_ids.0", "sse_service_object.test", "object_id"),
+				),
+			},
+		},
+	})
+}
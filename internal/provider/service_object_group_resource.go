@@ -0,0 +1,224 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/cisco/terraform-provider-sse/internal/apiclient"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ resource.Resource = &ServiceObjectGroupResource{}
+var _ resource.ResourceWithImportState = &ServiceObjectGroupResource{}
+var _ resource.ResourceWithValidateConfig = &ServiceObjectGroupResource{}
+
+func NewServiceObjectGroupResource() resource.Resource {
+	return &ServiceObjectGroupResource{}
+}
+
+type ServiceObjectGroupResource struct {
+	client *apiclient.APIClient
+}
+
+func (r *ServiceObjectGroupResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_service_object_group"
+}
+
+func (r *ServiceObjectGroupResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a Service Object Group. A group combines service objects and other service object groups so that rules can reference them as one set of protocols and ports.",
+		Attributes:  objectGroupResourceAttributes("Service"),
+	}
+}
+
+func (r *ServiceObjectGroupResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config ObjectGroupResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(validateObjectGroupMembers(config)...)
+}
+
+func (r *ServiceObjectGroupResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*apiclient.APIClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *apiclient.APIClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *ServiceObjectGroupResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan ObjectGroupResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	payload, diags := serviceObjectGroupPayload(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	group, err := apiclient.CreateServiceObjectGroup(ctx, r.client, payload)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating Service Object Group",
+			"Could not create service object group, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(mapServiceObjectGroupToModel(ctx, group, &plan)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *ServiceObjectGroupResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state ObjectGroupResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := state.ID.ValueInt64()
+
+	group, err := apiclient.GetServiceObjectGroup(ctx, r.client, id)
+	if removeMissingResource(ctx, resp, err, "Service object group", strconv.FormatInt(id, 10)) {
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading Service Object Group",
+			"Could not read service object group ID "+strconv.FormatInt(id, 10)+": "+err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(mapServiceObjectGroupToModel(ctx, group, &state)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *ServiceObjectGroupResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan ObjectGroupResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := plan.ID.ValueInt64()
+
+	payload, diags := serviceObjectGroupPayload(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The update replaces the whole group. Keep the inline values, which may
+	// have been added in the dashboard, instead of deleting them.
+	current, err := apiclient.GetServiceObjectGroup(ctx, r.client, id)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating Service Object Group",
+			"Could not read service object group ID "+strconv.FormatInt(id, 10)+": "+err.Error(),
+		)
+		return
+	}
+	if current.Values != nil {
+		payload.Values = current.Values
+	}
+
+	group, err := apiclient.UpdateServiceObjectGroup(ctx, r.client, id, payload)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating Service Object Group",
+			"Could not update service object group ID "+strconv.FormatInt(id, 10)+": "+err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(mapServiceObjectGroupToModel(ctx, group, &plan)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *ServiceObjectGroupResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state ObjectGroupResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := state.ID.ValueInt64()
+
+	err := apiclient.DeleteServiceObjectGroup(ctx, r.client, id)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error deleting Service Object Group",
+			"Could not delete service object group ID "+strconv.FormatInt(id, 10)+": "+err.Error(),
+		)
+		return
+	}
+}
+
+func (r *ServiceObjectGroupResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	id, err := strconv.ParseInt(req.ID, 10, 64)
+	if err != nil {
+		// Not a number, try to find by name
+		id, err = apiclient.GetServiceObjectGroupIDByName(ctx, r.client, req.ID)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error importing Service Object Group",
+				fmt.Sprintf("Could not find service object group with name %q: %s", req.ID, err),
+			)
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+}
+
+// serviceObjectGroupPayload builds the request body for model. Inline values
+// are not managed by the resource: new groups have none, and updates send
+// back the values of the existing group.
+func serviceObjectGroupPayload(ctx context.Context, model ObjectGroupResourceModel) (apiclient.ServiceObjectGroupPayload, diag.Diagnostics) {
+	payload := apiclient.ServiceObjectGroupPayload{
+		Name:        model.Name.ValueString(),
+		Description: model.Description.ValueString(),
+		Values:      []apiclient.ServiceObjectValue{},
+	}
+
+	var diags diag.Diagnostics
+	payload.ObjectIDs, payload.GroupIDs, diags = objectGroupMemberIDs(ctx, model)
+	return payload, diags
+}
+
+func mapServiceObjectGroupToModel(ctx context.Context, group *apiclient.ServiceObjectGroup, model *ObjectGroupResourceModel) diag.Diagnostics {
+	model.ID = types.Int64Value(group.ID)
+	model.Name = types.StringValue(group.Name)
+	model.Description = stringOrNull(group.Description)
+
+	return mapObjectGroupMembers(ctx, group.Objects, group.Groups, model)
+}
@@ -0,0 +1,98 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccServiceObjectGroupResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccServiceObjectGroupResourceConfig("tf-acc-test-svc-group", false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("sse_service_object_group.test", "name", "tf-acc-test-svc-group"),
+					resource.TestCheckResourceAttr("sse_service_object_group.test", "description", "Acceptance Test Service Object Group"),
+					resource.TestCheckResourceAttr("sse_service_object_group.test", "object_ids.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair("sse_service_object_group.test", "object_ids.*", "sse_service_object.https", "object_id"),
+					resource.TestCheckResourceAttrSet("sse_service_object_group.test", "id"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "sse_service_object_group.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// ImportState testing by name
+			{
+				ResourceName:      "sse_service_object_group.test",
+				ImportState:       true,
+				ImportStateId:     "tf-acc-test-svc-group",
+				ImportStateVerify: true,
+			},
+			// Update testing with a nested group
+			{
+				Config: testAccServiceObjectGroupResourceConfig("tf-acc-test-svc-group-updated", true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("sse_service_object_group.test", "name", "tf-acc-test-svc-group-updated"),
+					resource.TestCheckResourceAttr("sse_service_object_group.test", "group_ids.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair("sse_service_object_group.test", "group_ids.*", "sse_service_object_group.nested", "id"),
+				),
+			},
+			// Data source
+			{
+				Config: testAccServiceObjectGroupResourceConfig("tf-acc-test-svc-group-updated", true) + `
+data "sse_service_object_groups" "all" {
+  depends_on = [sse_service_object_group.test]
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.sse_service_object_groups.all", "service_object_groups.#"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccServiceObjectGroupResourceConfig(name string, nested bool) string {
+	groupIDs := ""
+	if nested {
+		groupIDs = "group_ids   = [sse_service_object_group.nested.id]"
+	}
+
+	return fmt.Sprintf(`
+resource "sse_service_object" "https" {
+  name     = "tf-acc-test-svc-group-https"
+  protocol = "tcp"
+  ports    = ["443"]
+}
+
+resource "sse_service_object" "dns" {
+  name     = "tf-acc-test-svc-group-dns"
+  protocol = "udp"
+  ports    = ["53"]
+}
+
+resource "sse_service_object_group" "nested" {
+  name       = "tf-acc-test-svc-group-nested"
+  object_ids = [sse_service_object.dns.object_id]
+}
+
+resource "sse_service_object_group" "test" {
+  name        = %[1]q
+  description = "Acceptance Test Service Object Group"
+  object_ids  = [sse_service_object.https.object_id]
+  %[2]s
+}
+`, name, groupIDs)
+}
@@ -0,0 +1,88 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cisco/terraform-provider-sse/internal/apiclient"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &ServiceObjectGroupsDataSource{}
+
+func NewServiceObjectGroupsDataSource() datasource.DataSource {
+	return &ServiceObjectGroupsDataSource{}
+}
+
+type ServiceObjectGroupsDataSource struct {
+	client *apiclient.APIClient
+}
+
+type ServiceObjectGroupsDataSourceModel struct {
+	ServiceObjectGroups []ObjectGroupModel `tfsdk:"service_object_groups"`
+}
+
+func (d *ServiceObjectGroupsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_service_object_groups"
+}
+
+func (d *ServiceObjectGroupsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Fetches the list of Service Object Groups.",
+		Attributes: map[string]schema.Attribute{
+			"service_object_groups": schema.ListNestedAttribute{
+				Computed: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: objectGroupDataSourceAttributes("service"),
+				},
+			},
+		},
+	}
+}
+
+func (d *ServiceObjectGroupsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*apiclient.APIClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *apiclient.APIClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *ServiceObjectGroupsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state ServiceObjectGroupsDataSourceModel
+
+	groups, err := apiclient.GetServiceObjectGroups(ctx, d.client)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Service Object Groups",
+			err.Error(),
+		)
+		return
+	}
+
+	for _, group := range groups {
+		state.ServiceObjectGroups = append(state.ServiceObjectGroups, ObjectGroupModel{
+			ID:          types.Int64Value(group.ID),
+			Name:        types.StringValue(group.Name),
+			Description: types.StringValue(group.Description),
+			ObjectIDs:   objectGroupMemberIDValues(group.Objects),
+			GroupIDs:    objectGroupMemberIDValues(group.Groups),
+		})
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
@@ -6,6 +6,7 @@ package provider
 import (
 	"context"
 	"fmt"
+	"slices"
 	"strconv"
 
 	"github.com/cisco/terraform-provider-sse/internal/apiclient"
@@ -20,6 +21,7 @@ import (
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &ServiceObjectResource{}
 var _ resource.ResourceWithImportState = &ServiceObjectResource{}
+var _ resource.ResourceWithModifyPlan = &ServiceObjectResource{}
 
 func NewServiceObjectResource() resource.Resource {
 	return &ServiceObjectResource{}
@@ -100,6 +102,57 @@ func (r *ServiceObjectResource) Configure(ctx context.Context, req resource.Conf
 	r.client = client
 }
 
+// ModifyPlan has the API validate new and changed service objects, so that
+// invalid protocols and port ranges fail at plan time instead of during the
+// apply.
+func (r *ServiceObjectResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to check when destroying
+	if req.Plan.Raw.IsNull() || r.client == nil {
+		return
+	}
+
+	// The model cannot hold an unknown list, e.g. ports taken from another
+	// resource, and such a plan cannot be validated anyway.
+	var ports types.List
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("ports"), &ports)...)
+	if resp.Diagnostics.HasError() || ports.IsUnknown() {
+		return
+	}
+
+	var plan, state ServiceObjectResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if !req.State.Raw.IsNull() {
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	changed := !plan.Name.Equal(state.Name) || !plan.Protocol.Equal(state.Protocol) || !plan.Description.Equal(state.Description) ||
+		!slices.EqualFunc(plan.Ports, state.Ports, func(a, b types.String) bool { return a.Equal(b) })
+	if !changed {
+		return
+	}
+	if !knownStrings(append([]types.String{plan.Name, plan.Description, plan.Protocol}, plan.Ports...)...) {
+		return
+	}
+
+	object := apiclient.CreateServiceObjectPayload{
+		Name:        plan.Name.ValueString(),
+		Description: plan.Description.ValueString(),
+		Value:       serviceObjectValue(plan.Protocol, plan.Ports),
+	}
+	err := apiclient.ValidateServiceObjects(ctx, r.client, []apiclient.CreateServiceObjectPayload{object})
+	resp.Diagnostics.Append(objectValidationDiagnostics(err, "Service Object", !req.State.Raw.IsNull() && plan.Name.Equal(state.Name), func(field string) path.Path {
+		switch field {
+		case "name", "description", "protocol":
+			return path.Root(field)
+		default:
+			return path.Root("ports")
+		}
+	}, nil)...)
+}
+
 func (r *ServiceObjectResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var data ServiceObjectResourceModel
 
@@ -109,21 +162,13 @@ func (r *ServiceObjectResource) Create(ctx context.Context, req resource.CreateR
 		return
 	}
 
-	var ports []string
-	for _, p := range data.Ports {
-		ports = append(ports, p.ValueString())
-	}
-
 	payload := apiclient.CreateServiceObjectPayload{
 		Name:        data.Name.ValueString(),
 		Description: data.Description.ValueString(),
-		Value: apiclient.ServiceObjectValue{
-			Protocol: data.Protocol.ValueString(),
-			Ports:    ports,
-		},
+		Value:       serviceObjectValue(data.Protocol, data.Ports),
 	}
 
-	object, err := apiclient.CreateServiceObject(r.client, payload)
+	object, err := apiclient.CreateServiceObject(ctx, r.client, payload)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error creating service object",
@@ -153,7 +198,10 @@ func (r *ServiceObjectResource) Read(ctx context.Context, req resource.ReadReque
 		return
 	}
 
-	object, err := apiclient.GetServiceObjectDetails(r.client, id)
+	object, err := apiclient.GetServiceObjectDetails(ctx, r.client, id)
+	if removeMissingResource(ctx, resp, err, "Service object", data.ID.ValueString()) {
+		return
+	}
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error reading service object",
@@ -191,21 +239,13 @@ func (r *ServiceObjectResource) Update(ctx context.Context, req resource.UpdateR
 		return
 	}
 
-	var ports []string
-	for _, p := range data.Ports {
-		ports = append(ports, p.ValueString())
-	}
-
 	payload := apiclient.UpdateServiceObjectPayload{
 		Name:        data.Name.ValueString(),
 		Description: data.Description.ValueString(),
-		Value: apiclient.ServiceObjectValue{
-			Protocol: data.Protocol.ValueString(),
-			Ports:    ports,
-		},
+		Value:       serviceObjectValue(data.Protocol, data.Ports),
 	}
 
-	_, err = apiclient.UpdateServiceObject(r.client, id, payload)
+	_, err = apiclient.UpdateServiceObject(ctx, r.client, id, payload)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error updating service object",
@@ -241,7 +281,7 @@ func (r *ServiceObjectResource) Delete(ctx context.Context, req resource.DeleteR
 		return
 	}
 
-	err = apiclient.DeleteServiceObject(r.client, id)
+	err = apiclient.DeleteServiceObject(ctx, r.client, id)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error deleting service object",
@@ -255,7 +295,7 @@ func (r *ServiceObjectResource) ImportState(ctx context.Context, req resource.Im
 	id := req.ID
 	if _, err := strconv.ParseInt(id, 10, 64); err != nil {
 		// Not a number, try to find by name
-		foundID, err := apiclient.GetServiceObjectIDByName(r.client, id)
+		foundID, err := apiclient.GetServiceObjectIDByName(ctx, r.client, id)
 		if err != nil {
 			resp.Diagnostics.AddError(
 				"Error importing service object",
@@ -267,3 +307,11 @@ func (r *ServiceObjectResource) ImportState(ctx context.Context, req resource.Im
 	}
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 }
+
+func serviceObjectValue(protocol types.String, ports []types.String) apiclient.ServiceObjectValue {
+	value := apiclient.ServiceObjectValue{Protocol: protocol.ValueString()}
+	for _, p := range ports {
+		value.Ports = append(value.Ports, p.ValueString())
+	}
+	return value
+}
@@ -17,7 +17,7 @@ func TestAccServiceObjectResource(t *testing.T) {
 		Steps: []resource.TestStep{
 			// Create and Read testing
 			{
-				Config: testAccServiceObjectResourceConfig("test-svc-obj", "TCP", "80", "443"),
+				Config: testAccServiceObjectResourceConfig("test-svc-obj", "Acceptance Test Service Object", "TCP", "80", "443"),
 				Check: resource.ComposeAggregateTestCheckFunc(
 					resource.TestCheckResourceAttr("sse_service_object.test", "name", "test-svc-obj"),
 					resource.TestCheckResourceAttr("sse_service_object.test", "protocol", "TCP"),
@@ -34,18 +34,27 @@ func TestAccServiceObjectResource(t *testing.T) {
 			},
 			// Update and Read testing
 			{
-				Config: testAccServiceObjectResourceConfig("test-svc-obj-updated", "UDP", "53"),
+				Config: testAccServiceObjectResourceConfig("test-svc-obj-updated", "Acceptance Test Service Object", "UDP", "53"),
 				Check: resource.ComposeAggregateTestCheckFunc(
 					resource.TestCheckResourceAttr("sse_service_object.test", "name", "test-svc-obj-updated"),
 					resource.TestCheckResourceAttr("sse_service_object.test", "protocol", "UDP"),
 					resource.TestCheckResourceAttr("sse_service_object.test", "ports.0", "53"),
 				),
 			},
+			// Update testing of the description only. The object keeps its
+			// name, which must not fail the plan-time validation.
+			{
+				Config: testAccServiceObjectResourceConfig("test-svc-obj-updated", "Updated description", "UDP", "53"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("sse_service_object.test", "name", "test-svc-obj-updated"),
+					resource.TestCheckResourceAttr("sse_service_object.test", "description", "Updated description"),
+				),
+			},
 		},
 	})
 }
 
-func testAccServiceObjectResourceConfig(name, protocol string, ports ...string) string {
+func testAccServiceObjectResourceConfig(name, description, protocol string, ports ...string) string {
 	portsConfig := ""
 	for _, p := range ports {
 		portsConfig += fmt.Sprintf(`"%s",`, p)
@@ -57,9 +66,9 @@ func testAccServiceObjectResourceConfig(name, protocol string, ports ...string)
 	return fmt.Sprintf(`
 resource "sse_service_object" "test" {
   name        = "%s"
-  description = "Acceptance Test Service Object"
+  description = %q
   protocol    = "%s"
   ports       = [%s]
 }
-`, name, protocol, portsConfig)
+`, name, description, protocol, portsConfig)
 }
@@ -0,0 +1,113 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cisco/terraform-provider-sse/internal/apiclient"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &SiteDataSource{}
+
+func NewSiteDataSource() datasource.DataSource {
+	return &SiteDataSource{}
+}
+
+type SiteDataSource struct {
+	client *apiclient.APIClient
+}
+
+func (d *SiteDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_site"
+}
+
+func (d *SiteDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	attributes := siteDataSourceAttributes()
+	attributes["id"] = schema.Int64Attribute{
+		Optional:    true,
+		Computed:    true,
+		Description: "The ID of the site to find. Either `id` or `name` must be set.",
+	}
+	attributes["name"] = schema.StringAttribute{
+		Optional:    true,
+		Computed:    true,
+		Description: "The name of the site to find. Either `id` or `name` must be set.",
+	}
+
+	resp.Schema = schema.Schema{
+		Description: "Fetches a single Site by ID or name.",
+		Attributes:  attributes,
+	}
+}
+
+func (d *SiteDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*apiclient.APIClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *apiclient.APIClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *SiteDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state SiteModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if state.ID.IsNull() && state.Name.IsNull() {
+		resp.Diagnostics.AddError(
+			"Missing Site Identifier",
+			"Either id or name must be set to look up a site.",
+		)
+		return
+	}
+
+	var site *apiclient.Site
+	var err error
+	if state.ID.IsNull() {
+		site, err = apiclient.GetSiteByName(ctx, d.client, state.Name.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Site Not Found",
+				err.Error(),
+			)
+			return
+		}
+	} else {
+		site, err = apiclient.GetSite(ctx, d.client, state.ID.ValueInt64())
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Unable to Read Site",
+				err.Error(),
+			)
+			return
+		}
+	}
+
+	state.ID = types.Int64Value(site.SiteID)
+	state.OriginID = types.Int64Value(site.OriginID)
+	state.Name = types.StringValue(site.Name)
+	state.IsDefault = types.BoolValue(site.IsDefault)
+	state.Type = types.StringValue(site.Type)
+	state.InternalNetworkCount = types.Int64Value(int64(site.InternalNetworkCount))
+	state.VACount = types.Int64Value(int64(site.VACount))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
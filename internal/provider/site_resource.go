@@ -0,0 +1,223 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/cisco/terraform-provider-sse/internal/apiclient"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ resource.Resource = &SiteResource{}
+var _ resource.ResourceWithImportState = &SiteResource{}
+
+func NewSiteResource() resource.Resource {
+	return &SiteResource{}
+}
+
+type SiteResource struct {
+	client *apiclient.APIClient
+}
+
+type SiteResourceModel struct {
+	ID                   types.Int64  `tfsdk:"id"`
+	OriginID             types.Int64  `tfsdk:"origin_id"`
+	Name                 types.String `tfsdk:"name"`
+	IsDefault            types.Bool   `tfsdk:"is_default"`
+	Type                 types.String `tfsdk:"type"`
+	InternalNetworkCount types.Int64  `tfsdk:"internal_network_count"`
+	VACount              types.Int64  `tfsdk:"va_count"`
+}
+
+func (r *SiteResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_site"
+}
+
+func (r *SiteResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a Site. Internal networks, internal domains and virtual appliances are attached to sites.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				Computed:    true,
+				Description: "The ID of the Site.",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"origin_id": schema.Int64Attribute{
+				Computed:    true,
+				Description: "The origin ID of the Site.",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:    true,
+				Description: "The name of the Site (1-255 characters).",
+			},
+			"is_default": schema.BoolAttribute{
+				Computed:    true,
+				Description: "Whether the Site is the default Site of the organization.",
+			},
+			"type": schema.StringAttribute{
+				Computed:    true,
+				Description: "The type of the Site.",
+			},
+			"internal_network_count": schema.Int64Attribute{
+				Computed:    true,
+				Description: "The number of internal networks attached to the Site.",
+			},
+			"va_count": schema.Int64Attribute{
+				Computed:    true,
+				Description: "The number of virtual appliances attached to the Site.",
+			},
+		},
+	}
+}
+
+func (r *SiteResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*apiclient.APIClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *apiclient.APIClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *SiteResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan SiteResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	site, err := apiclient.CreateSite(ctx, r.client, apiclient.SitePayload{Name: plan.Name.ValueString()})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating Site",
+			"Could not create site, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	mapSiteToModel(site, &plan)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *SiteResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state SiteResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := state.ID.ValueInt64()
+
+	site, err := apiclient.GetSite(ctx, r.client, id)
+	if removeMissingResource(ctx, resp, err, "Site", strconv.FormatInt(id, 10)) {
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading Site",
+			"Could not read site ID "+strconv.FormatInt(id, 10)+": "+err.Error(),
+		)
+		return
+	}
+
+	mapSiteToModel(site, &state)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *SiteResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan SiteResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := plan.ID.ValueInt64()
+
+	site, err := apiclient.UpdateSite(ctx, r.client, id, apiclient.SitePayload{Name: plan.Name.ValueString()})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating Site",
+			"Could not update site ID "+strconv.FormatInt(id, 10)+": "+err.Error(),
+		)
+		return
+	}
+
+	mapSiteToModel(site, &plan)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *SiteResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state SiteResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := state.ID.ValueInt64()
+
+	err := apiclient.DeleteSite(ctx, r.client, id)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error deleting Site",
+			"Could not delete site ID "+strconv.FormatInt(id, 10)+": "+err.Error(),
+		)
+		return
+	}
+}
+
+func (r *SiteResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	id, err := strconv.ParseInt(req.ID, 10, 64)
+	if err != nil {
+		// Not a number, try to find by name
+		site, err := apiclient.GetSiteByName(ctx, r.client, req.ID)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error importing Site",
+				fmt.Sprintf("Could not find site with name %q: %s", req.ID, err),
+			)
+			return
+		}
+		id = site.SiteID
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+}
+
+func mapSiteToModel(site *apiclient.Site, model *SiteResourceModel) {
+	model.ID = types.Int64Value(site.SiteID)
+	model.OriginID = types.Int64Value(site.OriginID)
+	model.Name = types.StringValue(site.Name)
+	model.IsDefault = types.BoolValue(site.IsDefault)
+	model.Type = types.StringValue(site.Type)
+	model.InternalNetworkCount = types.Int64Value(int64(site.InternalNetworkCount))
+	model.VACount = types.Int64Value(int64(site.VACount))
+}
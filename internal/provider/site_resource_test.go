@@ -0,0 +1,76 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccSiteResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccSiteResourceConfig("tf-acc-test-site"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("sse_site.test", "name", "tf-acc-test-site"),
+					resource.TestCheckResourceAttr("sse_site.test", "is_default", "false"),
+					resource.TestCheckResourceAttrSet("sse_site.test", "id"),
+					resource.TestCheckResourceAttrSet("sse_site.test", "origin_id"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "sse_site.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// ImportState testing by name
+			{
+				ResourceName:      "sse_site.test",
+				ImportState:       true,
+				ImportStateId:     "tf-acc-test-site",
+				ImportStateVerify: true,
+			},
+			// Update testing
+			{
+				Config: testAccSiteResourceConfig("tf-acc-test-site-updated"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("sse_site.test", "name", "tf-acc-test-site-updated"),
+				),
+			},
+			// Data sources
+			{
+				Config: testAccSiteResourceConfig("tf-acc-test-site-updated") + `
+data "sse_site" "by_name" {
+  name = sse_site.test.name
+}
+
+data "sse_sites" "all" {
+  depends_on = [sse_site.test]
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.sse_site.by_name", "id", "sse_site.test", "id"),
+					resource.TestCheckResourceAttrPair("data.sse_site.by_name", "origin_id", "sse_site.test", "origin_id"),
+					resource.TestCheckResourceAttrSet("data.sse_sites.all", "sites.#"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccSiteResourceConfig(name string) string {
+	return fmt.Sprintf(`
+resource "sse_site" "test" {
+  name = %[1]q
+}
+`, name)
+}
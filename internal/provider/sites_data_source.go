@@ -0,0 +1,134 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cisco/terraform-provider-sse/internal/apiclient"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &SitesDataSource{}
+
+func NewSitesDataSource() datasource.DataSource {
+	return &SitesDataSource{}
+}
+
+type SitesDataSource struct {
+	client *apiclient.APIClient
+}
+
+type SitesDataSourceModel struct {
+	Sites []SiteModel `tfsdk:"sites"`
+}
+
+type SiteModel struct {
+	ID                   types.Int64  `tfsdk:"id"`
+	OriginID             types.Int64  `tfsdk:"origin_id"`
+	Name                 types.String `tfsdk:"name"`
+	IsDefault            types.Bool   `tfsdk:"is_default"`
+	Type                 types.String `tfsdk:"type"`
+	InternalNetworkCount types.Int64  `tfsdk:"internal_network_count"`
+	VACount              types.Int64  `tfsdk:"va_count"`
+}
+
+func (d *SitesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_sites"
+}
+
+func (d *SitesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Fetches the list of Sites.",
+		Attributes: map[string]schema.Attribute{
+			"sites": schema.ListNestedAttribute{
+				Computed: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: siteDataSourceAttributes(),
+				},
+			},
+		},
+	}
+}
+
+// siteDataSourceAttributes returns the computed attributes of a site.
+func siteDataSourceAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.Int64Attribute{
+			Computed:    true,
+			Description: "The ID of the site.",
+		},
+		"origin_id": schema.Int64Attribute{
+			Computed:    true,
+			Description: "The origin ID of the site.",
+		},
+		"name": schema.StringAttribute{
+			Computed:    true,
+			Description: "The name of the site.",
+		},
+		"is_default": schema.BoolAttribute{
+			Computed:    true,
+			Description: "Whether the site is the default site of the organization.",
+		},
+		"type": schema.StringAttribute{
+			Computed:    true,
+			Description: "The type of the site.",
+		},
+		"internal_network_count": schema.Int64Attribute{
+			Computed:    true,
+			Description: "The number of internal networks attached to the site.",
+		},
+		"va_count": schema.Int64Attribute{
+			Computed:    true,
+			Description: "The number of virtual appliances attached to the site.",
+		},
+	}
+}
+
+func (d *SitesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*apiclient.APIClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *apiclient.APIClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *SitesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state SitesDataSourceModel
+
+	sites, err := apiclient.GetSites(ctx, d.client)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Sites",
+			err.Error(),
+		)
+		return
+	}
+
+	for _, site := range sites {
+		state.Sites = append(state.Sites, SiteModel{
+			ID:                   types.Int64Value(site.SiteID),
+			OriginID:             types.Int64Value(site.OriginID),
+			Name:                 types.StringValue(site.Name),
+			IsDefault:            types.BoolValue(site.IsDefault),
+			Type:                 types.StringValue(site.Type),
+			InternalNetworkCount: types.Int64Value(int64(site.InternalNetworkCount)),
+			VACount:              types.Int64Value(int64(site.VACount)),
+		})
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
@@ -89,7 +89,7 @@ func (d *TenantControlsProfilesDataSource) Configure(ctx context.Context, req da
 func (d *TenantControlsProfilesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
 	var state TenantControlsProfilesDataSourceModel
 
-	profiles, err := apiclient.GetTenantControlsProfiles(d.client)
+	profiles, err := apiclient.GetTenantControlsProfiles(ctx, d.client)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Unable to Read Tenant Controls Profiles",
@@ -182,7 +182,7 @@ func (d *TenantControlsProfileDataSource) Read(ctx context.Context, req datasour
 
 	name := state.Name.ValueString()
 
-	profiles, err := apiclient.GetTenantControlsProfiles(d.client)
+	profiles, err := apiclient.GetTenantControlsProfiles(ctx, d.client)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Unable to Read Tenant Controls Profiles",